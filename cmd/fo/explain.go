@@ -48,7 +48,7 @@ func runExplain(args []string, stdout, stderr io.Writer) int {
 		return 2
 	}
 
-	t := resolveTheme("auto", stdout)
+	t := resolveTheme("auto", colorAuto, stdout)
 	if f != nil {
 		fmt.Fprint(stdout, explainFinding(f, t))
 	} else {
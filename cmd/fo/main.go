@@ -12,10 +12,13 @@
 //
 // Output formats (--format):
 //
-//	auto   — TTY → human, piped → llm (default)
-//	human  — Tufte-Swiss styled terminal output
-//	llm    — token-dense plain text, no ANSI
-//	json   — machine-parseable Report JSON
+//	auto     — TTY → human, piped → llm (default)
+//	human    — Tufte-Swiss styled terminal output
+//	llm      — token-dense plain text, no ANSI
+//	json     — machine-parseable Report JSON
+//	markdown — GitHub-flavored Markdown for issues and PR descriptions
+//	html     — self-contained HTML fragment for archived CI summaries
+//	csv      — one table of findings and tests for spreadsheets
 package main
 
 import (
@@ -27,8 +30,13 @@ import (
 	"io"
 	"os"
 	"runtime/debug"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
 	"golang.org/x/term"
 
 	"github.com/dkoosis/fo/internal/boundread"
@@ -47,6 +55,12 @@ const (
 	formatLLM    = "llm"
 	formatJSON   = "json"
 	formatGitHub = "github"
+	// formatMarkdown emits GitHub-flavored Markdown for issues and PRs.
+	formatMarkdown = "markdown"
+	// formatHTML emits a self-contained HTML fragment for archiving.
+	formatHTML = "html"
+	// formatCSV emits a single CSV table for spreadsheets.
+	formatCSV = "csv"
 	// formatCast emits an asciinema v2 recording. It is Scene-native:
 	// only `# fo:scene` input animates, so other renderers reject it.
 	formatCast = "cast"
@@ -62,18 +76,22 @@ const (
 	flagTool      = "--tool"
 	flagHelp      = "--help"
 
-	subState       = "state"
-	subSuppress    = "suppress"
-	subWatch       = "watch"
-	subExplain     = "explain"
-	subTrend       = "trend"
-	subReplay      = "replay"
-	subWrap        = "wrap"
-	subDiag        = "diag"
-	subLeaderboard = "leaderboard"
-	subArchlint    = "archlint"
-	subJSCPD       = "jscpd"
-	subGofmt       = "gofmt"
+	subState        = "state"
+	subSuppress     = "suppress"
+	subWatch        = "watch"
+	subExplain      = "explain"
+	subTrend        = "trend"
+	subReplay       = "replay"
+	subRerun        = "rerun"
+	subWrap         = "wrap"
+	subDiag         = "diag"
+	subLeaderboard  = "leaderboard"
+	subArchlint     = "archlint"
+	subJSCPD        = "jscpd"
+	subGofmt        = "gofmt"
+	subCoverprofile = "coverprofile"
+	subGobench      = "gobench"
+	subSlowtests    = "slowtests"
 )
 
 // version is the build version. Override with -ldflags "-X main.version=v1.2.3".
@@ -84,7 +102,7 @@ var version = "dev"
 var (
 	errUnrecognizedInput    = errors.New("unrecognized input (expected SARIF or go test -json)")
 	errTruncatedTestJSON    = errors.New("no complete events recovered (truncated stream?)")
-	errUnknownFormat        = errors.New("unknown format (expected auto, human, llm, json, github)")
+	errUnknownFormat        = errors.New("unknown format (expected auto, human, llm, json, github, markdown, html, csv)")
 	errUnknownSectionFormat = errors.New("unknown section format")
 )
 
@@ -126,10 +144,30 @@ OUTPUT FORMATS (--format)
   json            Machine-parseable Report JSON
   github          GitHub Actions annotations (::error/::warning/::notice),
                   scoped to new findings when a diff baseline exists
+  markdown        GitHub-flavored Markdown (tables, emoji status) for
+                  pasting into issues and PR descriptions
+  html            Self-contained HTML fragment (inline CSS from the theme)
+                  for archiving CI summaries
+  csv             One table (findings + tests, or hygiene rows) for
+                  spreadsheets; not available for scenes
 
 FLAGS
-  --format <mode>     auto | human | llm | json | github (default: auto)
-  --theme <name>      color | mono (default: auto — color on TTY, mono otherwise)
+  --format <mode>     auto | human | llm | json | github | markdown | html | csv
+                      (default: auto)
+  --theme <name>      color | light | mono | mono-unicode | high-contrast
+                      (default: auto — color on a TTY, light if its
+                      background is light, mono otherwise)
+  --color <when>      auto | always | never (default: auto; FORCE_COLOR
+                      means always, NO_COLOR never, and NO_COLOR wins)
+  --quiet             Print nothing when the run is clean (exit 0, no
+                      findings); failures render as usual
+  --output <path>     Write the rendered result to <path> instead of stdout
+                      (auto format/theme resolve as for a pipe)
+  --tee <path>        Also write the rendered result to <path>, color
+                      stripped; the terminal keeps its colors
+  --tee-raw <path>    Like --tee, but keep color escapes in the file
+  --width <n>         Render at n columns instead of the terminal width
+                      (default: FO_WIDTH if set; for goldens/screenshots)
   --state-file <path> Sidecar state file (default: .fo/last-run.json)
   --no-state          Skip diff classification and sidecar I/O
   --state-strict      Exit non-zero (2) if sidecar Save fails
@@ -142,10 +180,18 @@ SUBCOMMANDS
   fo wrap <name>             Convert tool output to SARIF
   fo wrap list               List wrappers (--json for machine output)
   fo wrap --help             Show available wrappers
-  fo watch -- <cmd>          Run <cmd>, render output, rerun on stdin newline (A.1)
+  fo watch [--timeout=<dur>] [--spinner=<style>] [--notify=bell|os] -- <cmd>
+                             Run <cmd>, render output, rerun on stdin newline (A.1)
   fo explain <id>            Expand a handle (F-7a2/T-3f1) from the last run
-  fo trend <rule-id>         Chart a rule's count across recorded runs (sparkline)
-  fo replay [--since=<dur>]   List recent runs with headline counts
+  fo trend [--scale] <rule-id>
+                             Chart a rule's count across recorded runs
+                             (sparkline; --scale adds min/max/last)
+  fo replay [--since=<dur>] [--lines=N] [--offset=M] [--diff]
+                             List recent runs with headline counts
+                             (--diff: per-rule changes, last run vs the
+                             previous run of the same tool)
+  fo rerun [file]            Print go test -run commands for the last run's failures
+                             (file: fo --format json output or go test -json)
   fo suppress add|list|rm    Manage .fo/ignore suppressions (rule-id, glob, expiry)
   fo state reset             Clear diff classification baseline
   fo --version               Print build version and exit
@@ -180,7 +226,7 @@ EXIT CODES
   2   Usage error — bad flags, unrecognized input, stdin problems
 `
 
-func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) (code int) {
 	if len(args) > 0 {
 		switch args[0] {
 		case subWrap:
@@ -197,6 +243,8 @@ func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
 			return runTrend(args[1:], stdout, stderr)
 		case subReplay:
 			return runReplay(args[1:], stdout, stderr)
+		case subRerun:
+			return runRerun(args[1:], stdout, stderr)
 		case "help", "-h", flagHelp:
 			fmt.Fprint(stderr, usage)
 			return 0
@@ -219,8 +267,14 @@ func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
 	fs := flag.NewFlagSet("fo", flag.ContinueOnError)
 	fs.SetOutput(stderr)
 	fs.Usage = func() { fmt.Fprint(stderr, usage) }
-	formatFlag := fs.String("format", "auto", "Output format: auto, human, llm, json, github")
-	themeFlag := fs.String("theme", "auto", "Theme: auto, color, mono")
+	formatFlag := fs.String("format", "auto", "Output format: auto, human, llm, json, github, markdown, html, csv")
+	themeFlag := fs.String("theme", "auto", "Theme: auto, color, light, mono, mono-unicode, high-contrast")
+	colorFlag := fs.String("color", colorAuto, "Color: auto, always, never (FORCE_COLOR / NO_COLOR set the default)")
+	quietFlag := fs.Bool("quiet", false, "Print nothing when the run is clean (exit 0, no findings)")
+	widthFlag := fs.Int("width", 0, "Render width in columns instead of the terminal's (FO_WIDTH sets the default)")
+	outputFlag := fs.String("output", "", "Write the rendered result to this file instead of stdout")
+	teeFlag := fs.String("tee", "", "Also write the rendered result, color stripped, to this file")
+	teeRawFlag := fs.String("tee-raw", "", "Like --tee, but keep color escapes in the file")
 	stateFile := fs.String("state-file", state.Path(), "Sidecar state file path")
 	noStateFlag := fs.Bool("no-state", false, "Skip diff classification and sidecar I/O")
 	stateStrictFlag := fs.Bool("state-strict", false, "Exit non-zero if sidecar Save fails")
@@ -256,35 +310,102 @@ func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
 		return 2
 	}
 
-	mode, err := resolveFormat(*formatFlag, stdout)
+	if *teeFlag != "" && *teeRawFlag != "" {
+		fmt.Fprintln(stderr, "fo: --tee and --tee-raw are mutually exclusive")
+		return 2
+	}
+
+	// --output and --tee are not opened until every flag has been
+	// validated, so a typo never truncates either file; auto format resolves against a
+	// non-terminal writer, as it will for the file.
+	formatTarget := stdout
+	if *outputFlag != "" {
+		formatTarget = io.Discard
+	}
+	mode, err := resolveFormat(*formatFlag, formatTarget)
+	if err != nil {
+		fmt.Fprintf(stderr, "fo: %v\n", err)
+		return 2
+	}
+	color, err := resolveColor(*colorFlag)
+	if err != nil {
+		fmt.Fprintf(stderr, "fo: %v\n", err)
+		return 2
+	}
+	width, err := resolveWidth(*widthFlag)
 	if err != nil {
 		fmt.Fprintf(stderr, "fo: %v\n", err)
 		return 2
 	}
+	policy, perr := resolveStatePolicy(*noStateFlag, *stateStrictFlag)
+	if perr != nil {
+		fmt.Fprintf(stderr, "fo: %v\n", perr)
+		return 2
+	}
+	if *asFlag != "" {
+		if err := checkAsKind(*asFlag); err != nil {
+			fmt.Fprintf(stderr, "fo: %v\n", err)
+			return 2
+		}
+	}
+
+	if *outputFlag != "" {
+		// The file replaces stdout before any TTY-based decision, so theme
+		// and width resolve as for a pipe. Diagnostics stay on stderr.
+		f, err := os.Create(*outputFlag)
+		if err != nil {
+			fmt.Fprintf(stderr, "fo: %v\n", err)
+			return 2
+		}
+		defer closeOutput(f, stderr, &code)
+		stdout = f
+	}
+	if path := *teeFlag + *teeRawFlag; path != "" {
+		f, err := os.Create(path)
+		if err != nil {
+			fmt.Fprintf(stderr, "fo: %v\n", err)
+			return 2
+		}
+		defer closeOutput(f, stderr, &code)
+		stdout = newTee(stdout, f, *teeRawFlag != "")
+	}
+
+	out := outputOpts{themeName: *themeFlag, color: color, width: width, quiet: *quietFlag}
+	restoreVT, vtOK := enableVT(stdout)
+	defer restoreVT()
+	if !vtOK {
+		// A Windows console that refuses VT processing would print every
+		// escape literally; drop to the colorless theme instead.
+		out.color = colorNever
+	}
+	if out.color == colorAlways {
+		// lipgloss sizes its color profile from the real stdout and drops
+		// to plain ASCII in a pipe; forcing color has to override that too.
+		prev := lipgloss.ColorProfile()
+		lipgloss.SetColorProfile(termenv.ANSI256)
+		defer lipgloss.SetColorProfile(prev)
+	}
 
 	// Streaming dispatch: go test -json input only.
 	//   - TTY + format=auto → incremental render (existing path).
 	//   - --stream (any format) → incremental parse, single batch render.
 	// Non-go-test input (SARIF, multiplex) ignores --stream and falls
 	// through to the batch path.
-	policy, perr := resolveStatePolicy(*noStateFlag, *stateStrictFlag)
-	if perr != nil {
-		fmt.Fprintf(stderr, "fo: %v\n", perr)
-		return 2
-	}
 
 	if sniffGoTestJSON(peeked) {
-		ttyAuto := *formatFlag == "auto" && isTTYWriter(stdout)
+		// --quiet needs the whole run to know it was clean, so it takes
+		// the batch path even on a TTY.
+		ttyAuto := *formatFlag == "auto" && isTTYWriter(stdout) && !*quietFlag
 		switch {
 		case ttyAuto:
 			return runStream(streamOpts{
 				stdin: stdin, br: br, stdout: stdout, stderr: stderr,
-				theme: resolveTheme(*themeFlag, stdout), stateFile: *stateFile, policy: policy,
+				out: out, theme: out.theme(stdout), stateFile: *stateFile, policy: policy,
 			})
 		case *streamFlag:
 			return runStreamBatch(streamOpts{
 				stdin: stdin, br: br, stdout: stdout, stderr: stderr,
-				mode: mode, themeName: *themeFlag, stateFile: *stateFile, policy: policy,
+				mode: mode, out: out, stateFile: *stateFile, policy: policy,
 			})
 		}
 	}
@@ -312,17 +433,22 @@ func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
 		fmt.Fprintln(stderr, "fo: --format cast requires # fo:scene input")
 		return 2
 	}
+	// A scene is narration, not rows; there is no table to export.
+	if mode == formatCSV && scene.IsHeader(input) {
+		fmt.Fprintln(stderr, "fo: --format csv has no table for # fo:scene input")
+		return 2
+	}
 
 	if tally.IsHeader(input) {
-		return renderTally(input, stdout, stderr, mode, *themeFlag)
+		return renderTally(input, stdout, stderr, mode, out)
 	}
 
 	if status.IsHeader(input) {
-		return renderStatus(input, stdout, stderr, mode)
+		return renderStatus(input, stdout, stderr, mode, out)
 	}
 
 	if metrics.IsHeader(input) {
-		return renderMetrics(input, stdout, stderr, mode)
+		return renderMetrics(input, stdout, stderr, mode, out)
 	}
 
 	if scene.IsHeader(input) {
@@ -335,7 +461,7 @@ func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
 			fmt.Fprintf(stderr, "fo: tally auto-detect: %v\n", err)
 			return 2
 		}
-		return renderTally(buf.Bytes(), stdout, stderr, mode, *themeFlag)
+		return renderTally(buf.Bytes(), stdout, stderr, mode, out)
 	}
 
 	r, err := parseToReport(input, stderr)
@@ -368,7 +494,7 @@ func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
 		}
 	}
 
-	if err := renderMode(mode, r, stdout, *themeFlag, expandValues); err != nil {
+	if err := renderMode(mode, r, stdout, out, expandValues); err != nil {
 		fmt.Fprintf(stderr, "fo: %v\n", err)
 		return 2
 	}
@@ -404,37 +530,207 @@ func resolveFormat(format string, w io.Writer) (string, error) {
 			return formatHuman, nil
 		}
 		return formatLLM, nil
-	case formatHuman, formatLLM, formatJSON, formatCast, formatGitHub:
+	case formatHuman, formatLLM, formatJSON, formatCast, formatGitHub, formatMarkdown, formatHTML, formatCSV:
 		return format, nil
 	default:
 		return "", fmt.Errorf("%w: %q", errUnknownFormat, format)
 	}
 }
 
-// resolveTheme picks the theme. NO_COLOR env or non-TTY stdout forces mono;
-// explicit --theme overrides auto.
-func resolveTheme(name string, w io.Writer) theme.Theme {
+// Values of --color. auto keeps the TTY-based decision; always and never
+// override it in either direction.
+const (
+	colorAuto   = "auto"
+	colorAlways = "always"
+	colorNever  = "never"
+)
+
+var errUnknownColor = errors.New("unknown --color (want auto, always, never)")
+
+// resolveColor settles --color against the environment. The flag wins;
+// left at auto, NO_COLOR means never and FORCE_COLOR (any value but "0")
+// means always. NO_COLOR beats FORCE_COLOR when both are set, so a log
+// pipeline that opted out of ANSI stays clean.
+func resolveColor(flagValue string) (string, error) {
+	switch flagValue {
+	case colorAlways, colorNever:
+		return flagValue, nil
+	case colorAuto:
+	default:
+		return "", fmt.Errorf("%w: %q", errUnknownColor, flagValue)
+	}
 	if os.Getenv("NO_COLOR") != "" {
+		return colorNever, nil
+	}
+	if v := os.Getenv("FORCE_COLOR"); v != "" && v != "0" {
+		return colorAlways, nil
+	}
+	return colorAuto, nil
+}
+
+// resolveTheme picks the theme from --theme and --color. never forces
+// mono, except that an explicit colorless preset (mono-unicode) still
+// applies since it adds no color. always skips TTY detection: an explicit
+// --theme applies, otherwise Color. auto defers to the environment first,
+// then lets an explicit --theme win and otherwise detects from the output.
+func resolveTheme(name, color string, w io.Writer) theme.Theme {
+	if color == colorAuto {
+		color, _ = resolveColor(colorAuto)
+	}
+	t, named := namedTheme(name)
+	switch color {
+	case colorNever:
+		if named && t.Colorless() {
+			return t
+		}
 		return theme.Mono()
+	case colorAlways:
+		if named {
+			return t
+		}
+		return theme.Color()
+	}
+	if named {
+		return t
+	}
+	f, _ := terminalOf(w).(*os.File)
+	return autoTheme(isTTYWriter(w), func() bool {
+		return darkBackground(func() bool { return lipgloss.NewRenderer(f).HasDarkBackground() })
+	})
+}
+
+// bgQueryTimeout bounds the wait for the terminal's OSC 11 reply. A
+// terminal that answers does so within milliseconds; termenv's own wait
+// is 5s, which a silent terminal would charge to every run.
+const bgQueryTimeout = 200 * time.Millisecond
+
+var (
+	bgOnce sync.Once
+	bgDark bool
+)
+
+// darkBackground answers query once per process and caches the result:
+// the query is a round trip on the terminal, and repeating it per render
+// (each fo watch rerun) would race watch's raw-mode key reader for the
+// reply. No answer within bgQueryTimeout counts as dark, the default.
+func darkBackground(query func() bool) bool {
+	bgOnce.Do(func() { bgDark = queryWithin(query, bgQueryTimeout) })
+	return bgDark
+}
+
+// queryWithin runs query, giving up after d and reporting dark. An
+// abandoned query finishes on its own once termenv's wait expires.
+func queryWithin(query func() bool, d time.Duration) bool {
+	ch := make(chan bool, 1)
+	go func() { ch <- query() }()
+	select {
+	case dark := <-ch:
+		return dark
+	case <-time.After(d):
+		return true
 	}
+}
+
+// autoTheme is the --theme auto pick: theme.Default, except that a TTY
+// reporting a light background gets Light, since Color's pale shades wash
+// out on white. hasDark (an OSC 11 query, falling back to COLORFGBG, then
+// dark) is consulted only on a TTY: the query writes to the terminal and
+// waits for its reply, which must never happen in a pipe or CI log.
+func autoTheme(isTTY bool, hasDark func() bool) theme.Theme {
+	if isTTY && !hasDark() {
+		return theme.Light()
+	}
+	return theme.Default(theme.OutputKindFromTTY(isTTY))
+}
+
+// namedTheme maps an explicit --theme value to its preset; ok is false for
+// "auto" (and unknown names), which defer to environment detection.
+func namedTheme(name string) (theme.Theme, bool) {
 	switch name {
 	case "color":
-		return theme.Color()
+		return theme.Color(), true
 	case "mono":
-		return theme.Mono()
-	default:
-		return theme.Default(theme.OutputKindFromTTY(isTTYWriter(w)))
+		return theme.Mono(), true
+	case "mono-unicode":
+		return theme.MonoUnicode(), true
+	case "light":
+		return theme.Light(), true
+	case "high-contrast":
+		return theme.HighContrast(), true
 	}
+	return theme.Theme{}, false
+}
+
+// enableVT turns on virtual-terminal processing when w is a Windows
+// console, so ANSI escapes render instead of printing as text. It is a
+// no-op elsewhere and for non-console writers. ok is false only when the
+// console refuses the mode; restore undoes any change.
+func enableVT(w io.Writer) (restore func(), ok bool) {
+	f, isFile := terminalOf(w).(*os.File)
+	if !isFile {
+		return func() {}, true
+	}
+	undo, err := termenv.EnableVirtualTerminalProcessing(termenv.NewOutput(f))
+	if err != nil {
+		return func() {}, false
+	}
+	return func() { _ = undo() }, true
 }
 
 func isTTYWriter(w io.Writer) bool {
-	f, ok := w.(*os.File)
+	f, ok := terminalOf(w).(*os.File)
 	return ok && term.IsTerminal(int(f.Fd()))
 }
 
+var errBadWidth = errors.New("width must be a positive number of columns")
+
+// resolveWidth settles --width against FO_WIDTH: the flag wins, the env
+// var is the default, and 0 from both means "ask the terminal". A fixed
+// width makes output reproducible for goldens and screenshots.
+func resolveWidth(flagValue int) (int, error) {
+	if flagValue != 0 {
+		if flagValue < 0 {
+			return 0, fmt.Errorf("--width: %w", errBadWidth)
+		}
+		return flagValue, nil
+	}
+	env := os.Getenv("FO_WIDTH")
+	if env == "" {
+		return 0, nil
+	}
+	n, err := strconv.Atoi(env)
+	if err != nil || n <= 0 {
+		return 0, fmt.Errorf("FO_WIDTH=%q: %w", env, errBadWidth)
+	}
+	return n, nil
+}
+
+// outputOpts carries the presentation flags shared by every render path:
+// --theme as given, --color as resolved, a fixed --width (0 = auto), and
+// --quiet.
+type outputOpts struct {
+	themeName string
+	color     string
+	width     int
+	quiet     bool
+}
+
+func (o outputOpts) theme(w io.Writer) theme.Theme {
+	return resolveTheme(o.themeName, o.color, w)
+}
+
+// widthFor is the render width for w: the fixed width if one was set,
+// otherwise the terminal's, re-read on every call.
+func (o outputOpts) widthFor(w io.Writer) int {
+	if o.width > 0 {
+		return o.width
+	}
+	return termSize(w)
+}
+
 func termSize(w io.Writer) int {
 	width := 80
-	if f, ok := w.(*os.File); ok {
+	if f, ok := terminalOf(w).(*os.File); ok {
 		if tw, _, err := term.GetSize(int(f.Fd())); err == nil {
 			if tw > 0 {
 				width = tw
@@ -0,0 +1,171 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dkoosis/fo/pkg/report"
+)
+
+func TestAutoTheme(t *testing.T) {
+	t.Setenv("NO_COLOR", "")
+	queried := false
+	probe := func(dark bool) func() bool {
+		return func() bool { queried = true; return dark }
+	}
+
+	if got := autoTheme(true, probe(false)).Name; got != "light" {
+		t.Errorf("TTY with light background = %q, want light", got)
+	}
+	if got := autoTheme(true, probe(true)).Name; got != "color" {
+		t.Errorf("TTY with dark background = %q, want color", got)
+	}
+
+	queried = false
+	if got := autoTheme(false, probe(false)).Name; got != "mono" {
+		t.Errorf("pipe = %q, want mono", got)
+	}
+	if queried {
+		t.Error("background must not be queried when output is not a TTY")
+	}
+}
+
+func TestQueryWithin_SilentTerminalCountsAsDark(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	start := time.Now()
+	if !queryWithin(func() bool { <-release; return false }, 20*time.Millisecond) {
+		t.Error("an unanswered query should fall back to dark")
+	}
+	if d := time.Since(start); d > time.Second {
+		t.Errorf("query waited %s, want it bounded by the timeout", d)
+	}
+	if queryWithin(func() bool { return false }, time.Second) {
+		t.Error("a prompt light answer should be kept")
+	}
+}
+
+func TestResolveTheme_ExplicitNameWins(t *testing.T) {
+	t.Setenv("NO_COLOR", "")
+	var buf bytes.Buffer
+	for _, name := range []string{"color", "light", "mono", "mono-unicode", "high-contrast"} {
+		if got := resolveTheme(name, colorAuto, &buf).Name; got != name {
+			t.Errorf("resolveTheme(%q) = %q", name, got)
+		}
+	}
+	if got := resolveTheme("auto", colorAuto, &buf).Name; got != "mono" {
+		t.Errorf("auto on a buffer = %q, want mono", got)
+	}
+}
+
+func TestResolveTheme_NoColorKeepsColorlessPreset(t *testing.T) {
+	t.Setenv("NO_COLOR", "1")
+	var buf bytes.Buffer
+	if got := resolveTheme("mono-unicode", colorAuto, &buf).Name; got != "mono-unicode" {
+		t.Errorf("NO_COLOR + mono-unicode = %q, want mono-unicode", got)
+	}
+	if got := resolveTheme("color", colorAuto, &buf).Name; got != "mono" {
+		t.Errorf("NO_COLOR + color = %q, want mono", got)
+	}
+}
+
+func TestResolveColor(t *testing.T) {
+	cases := []struct {
+		flag, noColor, force, want string
+	}{
+		{"auto", "", "", colorAuto},
+		{"auto", "", "1", colorAlways},
+		{"auto", "", "0", colorAuto},
+		{"auto", "1", "", colorNever},
+		{"auto", "1", "1", colorNever},
+		{"always", "1", "", colorAlways},
+		{"never", "", "1", colorNever},
+	}
+	for _, c := range cases {
+		t.Setenv("NO_COLOR", c.noColor)
+		t.Setenv("FORCE_COLOR", c.force)
+		got, err := resolveColor(c.flag)
+		if err != nil || got != c.want {
+			t.Errorf("resolveColor(%q) NO_COLOR=%q FORCE_COLOR=%q = %q, %v; want %q",
+				c.flag, c.noColor, c.force, got, err, c.want)
+		}
+	}
+	if _, err := resolveColor("sometimes"); err == nil {
+		t.Error("resolveColor(sometimes) should fail")
+	}
+}
+
+func TestResolveTheme_ColorOverride(t *testing.T) {
+	t.Setenv("NO_COLOR", "")
+	t.Setenv("FORCE_COLOR", "")
+	var buf bytes.Buffer
+	if got := resolveTheme("auto", colorAlways, &buf).Name; got != "color" {
+		t.Errorf("always on a buffer = %q, want color", got)
+	}
+	if got := resolveTheme("light", colorAlways, &buf).Name; got != "light" {
+		t.Errorf("always + light = %q, want light", got)
+	}
+	if got := resolveTheme("color", colorNever, &buf).Name; got != "mono" {
+		t.Errorf("never + color = %q, want mono", got)
+	}
+}
+
+func TestResolveWidth(t *testing.T) {
+	t.Setenv("FO_WIDTH", "")
+	if got, err := resolveWidth(0); err != nil || got != 0 {
+		t.Errorf("unset = %d, %v; want 0 (terminal)", got, err)
+	}
+	t.Setenv("FO_WIDTH", "100")
+	if got, _ := resolveWidth(0); got != 100 {
+		t.Errorf("FO_WIDTH=100 = %d, want 100", got)
+	}
+	if got, _ := resolveWidth(60); got != 60 {
+		t.Errorf("--width 60 over FO_WIDTH = %d, want 60", got)
+	}
+	if _, err := resolveWidth(-1); err == nil {
+		t.Error("negative --width should fail")
+	}
+	t.Setenv("FO_WIDTH", "wide")
+	if _, err := resolveWidth(0); err == nil {
+		t.Error("non-numeric FO_WIDTH should fail")
+	}
+}
+
+func TestOutputOpts_FixedWidthWins(t *testing.T) {
+	var buf bytes.Buffer
+	if got := (outputOpts{width: 42}).widthFor(&buf); got != 42 {
+		t.Errorf("widthFor = %d, want 42", got)
+	}
+	if got := (outputOpts{}).widthFor(&buf); got != 80 {
+		t.Errorf("widthFor without a terminal = %d, want 80", got)
+	}
+}
+
+func TestWriteReportJSON_StampsSchemaVersion(t *testing.T) {
+	t.Parallel()
+
+	r := &report.Report{Tool: "x"}
+	var buf bytes.Buffer
+	if err := writeReportJSON(&buf, r); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buf.String(), fmt.Sprintf(`"schema_version": %d`, report.SchemaVersion)) {
+		t.Errorf("schema_version missing:\n%s", buf.String())
+	}
+	if r.SchemaVersion != 0 {
+		t.Error("writeReportJSON should not mutate the caller's Report")
+	}
+}
+
+func TestEnableVT_NonConsoleIsNoop(t *testing.T) {
+	t.Parallel()
+
+	restore, ok := enableVT(&bytes.Buffer{})
+	if !ok {
+		t.Error("a non-console writer needs no VT mode and should report ok")
+	}
+	restore()
+}
@@ -45,10 +45,19 @@ func coerceAs(kind string, input []byte, stderr io.Writer) ([]byte, int) {
 		}
 		return buf.Bytes(), 0
 	}
-	fmt.Fprintf(stderr, "fo: --as: unknown kind %q (want tally|status|metrics|diag)\n", kind)
+	fmt.Fprintf(stderr, "fo: %v\n", checkAsKind(kind))
 	return nil, 2
 }
 
+// checkAsKind validates an --as value before any input is read.
+func checkAsKind(kind string) error {
+	switch kind {
+	case "tally", "status", "metrics", subDiag:
+		return nil
+	}
+	return fmt.Errorf("--as: unknown kind %q (want tally|status|metrics|diag)", kind)
+}
+
 // sniffBareTally returns true when every non-blank/non-comment line
 // looks like "<number> <label>". Conservative — requires ≥2 rows so a
 // single stray "404 not_found" log line never triggers leaderboard.
@@ -18,20 +18,32 @@ import (
 	"github.com/dkoosis/fo/pkg/view"
 )
 
-func renderMode(mode string, r *report.Report, stdout io.Writer, themeName string, expandValues []string) error {
+func renderMode(mode string, r *report.Report, stdout io.Writer, out outputOpts, expandValues []string) error {
+	if out.quiet && quietClean(r) {
+		return nil
+	}
 	if mode == formatJSON {
 		return writeReportJSON(stdout, r)
 	}
 	if mode == formatGitHub {
 		return view.RenderGitHub(stdout, *r)
 	}
-	t := resolveTheme(themeName, stdout)
+	if mode == formatMarkdown {
+		return view.RenderMarkdown(stdout, *r)
+	}
+	if mode == formatCSV {
+		return view.RenderCSV(stdout, *r)
+	}
+	if mode == formatHTML {
+		return view.RenderHTML(stdout, *r, htmlTheme(out))
+	}
+	t := out.theme(stdout)
 	viewMode := view.ModeHuman
 	if mode == formatLLM {
 		t = theme.Mono()
 		viewMode = view.ModeLLM
 	}
-	width := termSize(stdout)
+	width := out.widthFor(stdout)
 	expand := view.NewExpandSet(expandValues)
 	if err := view.RenderReportModeWithExpand(stdout, *r, t, width, viewMode, expand); err != nil {
 		return err
@@ -42,30 +54,64 @@ func renderMode(mode string, r *report.Report, stdout io.Writer, themeName strin
 	return nil
 }
 
+// htmlTheme is the preset for --format html. An HTML archive is read in a
+// browser, not on this stdout, so TTY detection says nothing about color;
+// only an explicit --theme changes the preset.
+func htmlTheme(out outputOpts) theme.Theme {
+	if t, ok := namedTheme(out.themeName); ok {
+		return t
+	}
+	return theme.Color()
+}
+
+// quietClean reports whether --quiet should swallow the output: exit 0
+// and nothing worth reading — no findings of any severity, no notices.
+// Hygiene inputs never reach here; they carry no verdict, so --quiet
+// leaves them alone.
+func quietClean(r *report.Report) bool {
+	return exitCodeReport(r) == 0 && len(r.Findings) == 0 && len(r.Notices) == 0
+}
+
+// hygieneWriters are the per-format writers a hygiene input supplies to
+// renderHygiene. llm and human are required; a nil csv, markdown, or
+// html writer means the input has no form in that format.
+type hygieneWriters struct {
+	llm, human, csv, markdown, html func(io.Writer) error
+}
+
 // renderHygiene dispatches the format switch shared by the hygiene
 // renderers (tally/status/metrics/scene). Each caller supplies the
-// JSON-encodable value plus closures for the LLM and human writers; the
-// helper handles encoding, error reporting, and the exit code. Returns 0
-// on success, 2 on writer error.
-func renderHygiene(stdout, stderr io.Writer, mode string, jsonValue any, llmFn, humanFn func(io.Writer) error) int {
+// JSON-encodable value plus its writers; the helper handles encoding,
+// error reporting, and the exit code. A format the input has no writer
+// for is a usage error rather than a silent fall back to terminal output.
+// Returns 0 on success, 2 on an unavailable format or writer error.
+func renderHygiene(stdout, stderr io.Writer, mode string, jsonValue any, ws hygieneWriters) int {
+	var fn func(io.Writer) error
 	switch mode {
+	case formatCSV:
+		fn = ws.csv
+	case formatMarkdown:
+		fn = ws.markdown
+	case formatHTML:
+		fn = ws.html
 	case formatJSON:
-		enc := json.NewEncoder(stdout)
-		enc.SetIndent("", "  ")
-		if err := enc.Encode(jsonValue); err != nil {
-			fmt.Fprintf(stderr, "fo: %v\n", err)
-			return 2
+		fn = func(w io.Writer) error {
+			enc := json.NewEncoder(w)
+			enc.SetIndent("", "  ")
+			return enc.Encode(jsonValue)
 		}
 	case formatLLM:
-		if err := llmFn(stdout); err != nil {
-			fmt.Fprintf(stderr, "fo: %v\n", err)
-			return 2
-		}
+		fn = ws.llm
 	default:
-		if err := humanFn(stdout); err != nil {
-			fmt.Fprintf(stderr, "fo: %v\n", err)
-			return 2
-		}
+		fn = ws.human
+	}
+	if fn == nil {
+		fmt.Fprintf(stderr, "fo: --format %s is not available for this input\n", mode)
+		return 2
+	}
+	if err := fn(stdout); err != nil {
+		fmt.Fprintf(stderr, "fo: %v\n", err)
+		return 2
 	}
 	return 0
 }
@@ -75,7 +121,7 @@ func renderHygiene(stdout, stderr io.Writer, mode string, jsonValue any, llmFn,
 // callers explicitly asked for a count-weighted bar chart, not a
 // severity-aggregated one. Always exits 0 on success: a tally is
 // informational, not pass/fail.
-func renderTally(input []byte, stdout io.Writer, stderr io.Writer, mode, themeName string) int {
+func renderTally(input []byte, stdout io.Writer, stderr io.Writer, mode string, opts outputOpts) int {
 	t, err := tally.Parse(bytes.NewReader(input))
 	if err != nil {
 		fmt.Fprintf(stderr, "fo: parsing tally: %v\n", err)
@@ -89,15 +135,19 @@ func renderTally(input []byte, stdout io.Writer, stderr io.Writer, mode, themeNa
 	for _, r := range t.Rows {
 		jsonOut.Total += r.Value
 	}
-	return renderHygiene(stdout, stderr, mode, jsonOut,
-		func(w io.Writer) error { return view.RenderLeaderboardLLM(w, t.ToLeaderboard()) },
-		func(w io.Writer) error {
-			th := resolveTheme(themeName, w)
-			width := termSize(w)
-			out := view.Render(t.ToLeaderboard(), th, width)
+	return renderHygiene(stdout, stderr, mode, jsonOut, hygieneWriters{
+		llm: func(w io.Writer) error { return view.RenderLeaderboardLLM(w, t.ToLeaderboard()) },
+		human: func(w io.Writer) error {
+			out := view.Render(t.ToLeaderboard(), opts.theme(w), opts.widthFor(w))
 			_, werr := fmt.Fprintln(w, out)
 			return werr
-		})
+		},
+		csv:      func(w io.Writer) error { return view.RenderLeaderboardCSV(w, t.ToLeaderboard()) },
+		markdown: func(w io.Writer) error { return view.RenderLeaderboardMarkdown(w, t.Tool, t.ToLeaderboard()) },
+		html: func(w io.Writer) error {
+			return view.RenderLeaderboardHTML(w, t.Tool, t.ToLeaderboard(), htmlTheme(opts))
+		},
+	})
 }
 
 // castDelay assigns the pause before each beat of a cast recording.
@@ -128,16 +178,17 @@ func renderScene(input []byte, stdout io.Writer, stderr io.Writer, mode string)
 		}
 		return 0
 	}
-	return renderHygiene(stdout, stderr, mode, s,
-		func(w io.Writer) error { return view.RenderSceneLLM(w, s) },
-		func(w io.Writer) error { return view.RenderSceneHuman(w, s) })
+	return renderHygiene(stdout, stderr, mode, s, hygieneWriters{
+		llm:   func(w io.Writer) error { return view.RenderSceneLLM(w, s) },
+		human: func(w io.Writer) error { return view.RenderSceneHuman(w, s) },
+	})
 }
 
 // renderStatus parses status-format input and emits the PASS/FAIL table.
 // Always exits 0 on success — status streams are reports, not gates;
 // callers decide pass/fail by inspecting the rows themselves (or via the
 // parsed json).
-func renderStatus(input []byte, stdout io.Writer, stderr io.Writer, mode string) int {
+func renderStatus(input []byte, stdout io.Writer, stderr io.Writer, mode string, opts outputOpts) int {
 	s, err := status.Parse(bytes.NewReader(input))
 	if err != nil {
 		fmt.Fprintf(stderr, "fo: parsing status: %v\n", err)
@@ -147,15 +198,19 @@ func renderStatus(input []byte, stdout io.Writer, stderr io.Writer, mode string)
 	for i, r := range s.Rows {
 		rows[i] = view.StatusRow{State: string(r.State), Label: r.Label, Value: r.Value, Note: r.Note}
 	}
-	return renderHygiene(stdout, stderr, mode, s,
-		func(w io.Writer) error { return view.RenderStatusLLM(w, s.Tool, rows) },
-		func(w io.Writer) error { return view.RenderStatusHuman(w, s.Tool, rows) })
+	return renderHygiene(stdout, stderr, mode, s, hygieneWriters{
+		llm:      func(w io.Writer) error { return view.RenderStatusLLM(w, s.Tool, rows) },
+		human:    func(w io.Writer) error { return view.RenderStatusHuman(w, s.Tool, rows) },
+		csv:      func(w io.Writer) error { return view.RenderStatusCSV(w, rows) },
+		markdown: func(w io.Writer) error { return view.RenderStatusMarkdown(w, s.Tool, rows) },
+		html:     func(w io.Writer) error { return view.RenderStatusHTML(w, s.Tool, rows, htmlTheme(opts)) },
+	})
 }
 
 // renderMetrics parses metrics-format input, computes deltas against
 // the sidecar history, renders, and saves the new sample set. Always
 // exits 0 on success — metrics streams are informational rollups.
-func renderMetrics(input []byte, stdout io.Writer, stderr io.Writer, mode string) int {
+func renderMetrics(input []byte, stdout io.Writer, stderr io.Writer, mode string, opts outputOpts) int {
 	m, err := metrics.Parse(bytes.NewReader(input))
 	if err != nil {
 		fmt.Fprintf(stderr, "fo: parsing metrics: %v\n", err)
@@ -183,9 +238,13 @@ func renderMetrics(input []byte, stdout io.Writer, stderr io.Writer, mode string
 		Tool   string              `json:"tool,omitempty"`
 		Deltas []state.MetricDelta `json:"deltas"`
 	}{Tool: m.Tool, Deltas: deltas}
-	if code := renderHygiene(stdout, stderr, mode, jsonOut,
-		func(w io.Writer) error { return view.RenderMetricsLLM(w, m.Tool, rows) },
-		func(w io.Writer) error { return view.RenderMetricsHuman(w, m.Tool, rows) }); code != 0 {
+	if code := renderHygiene(stdout, stderr, mode, jsonOut, hygieneWriters{
+		llm:      func(w io.Writer) error { return view.RenderMetricsLLM(w, m.Tool, rows) },
+		human:    func(w io.Writer) error { return view.RenderMetricsHuman(w, m.Tool, rows) },
+		csv:      func(w io.Writer) error { return view.RenderMetricsCSV(w, rows) },
+		markdown: func(w io.Writer) error { return view.RenderMetricsMarkdown(w, m.Tool, rows) },
+		html:     func(w io.Writer) error { return view.RenderMetricsHTML(w, m.Tool, rows, htmlTheme(opts)) },
+	}); code != 0 {
 		return code
 	}
 
@@ -198,8 +257,14 @@ func renderMetrics(input []byte, stdout io.Writer, stderr io.Writer, mode string
 	}
 	return 0
 }
+
+// writeReportJSON emits r as indented JSON stamped with the current
+// report.SchemaVersion. The stamp goes on a copy so the caller's Report
+// is left as parsed.
 func writeReportJSON(w io.Writer, r *report.Report) error {
+	out := *r
+	out.SchemaVersion = report.SchemaVersion
 	enc := json.NewEncoder(w)
 	enc.SetIndent("", "  ")
-	return enc.Encode(r)
+	return enc.Encode(&out)
 }
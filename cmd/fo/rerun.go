@@ -0,0 +1,144 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/dkoosis/fo/pkg/report"
+	"github.com/dkoosis/fo/pkg/state"
+	"github.com/dkoosis/fo/pkg/testjson"
+)
+
+// runRerun handles `fo rerun [file]` — it turns the failing tests of a
+// prior run into ready-to-paste `go test -run` commands, one per package,
+// so a large suite can be narrowed to just what broke. With no file it
+// reads the last run's findings snapshot; a file may hold either a
+// `fo --format json` Report or captured `go test -json` output.
+func runRerun(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("fo rerun", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "usage: fo rerun [file]   (file: fo --format json output or go test -json; default: last run)")
+	}
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return 2
+	}
+	if fs.NArg() > 1 {
+		fs.Usage()
+		return 2
+	}
+	path := fs.Arg(0)
+
+	var tests []report.TestResult
+	if path == "" {
+		snap, err := state.LoadSnapshot(state.SnapshotPath())
+		if err != nil {
+			fmt.Fprintf(stderr, "fo rerun: %v\n", err)
+			return 2
+		}
+		if snap == nil {
+			fmt.Fprintln(stderr, "fo rerun: no prior run found — pipe go test -json through fo first, or pass a file")
+			return 2
+		}
+		tests = snap.Tests
+	} else {
+		data, err := os.ReadFile(path) //nolint:gosec // user-supplied path is the contract
+		if err != nil {
+			fmt.Fprintf(stderr, "fo rerun: %v\n", err)
+			return 2
+		}
+		tests = rerunTestsFromBytes(data)
+	}
+
+	cmds := rerunCommands(tests)
+	if len(cmds) == 0 {
+		fmt.Fprintln(stderr, "fo rerun: no failing tests to rerun")
+		return 0
+	}
+	for _, c := range cmds {
+		fmt.Fprintln(stdout, c)
+	}
+	return 0
+}
+
+// rerunTestsFromBytes reads a `fo --format json` Report, falling back to a
+// `go test -json` stream when the bytes don't decode as one (a multi-event
+// stream is not a single JSON value).
+func rerunTestsFromBytes(data []byte) []report.TestResult {
+	var r report.Report
+	if err := json.Unmarshal(data, &r); err == nil && len(r.Tests) > 0 {
+		return r.Tests
+	}
+	results, _, err := testjson.ParseBytes(bytes.TrimSpace(data))
+	if err != nil {
+		return nil
+	}
+	return testjson.ToReport(results).Tests
+}
+
+// rerunCommands groups failing tests by package into one `go test -run`
+// line each, sorted by package. Subtest failures collapse onto their
+// top-level test: a failing subtest fails its parent too, and while
+// -run '^TestA$/^sub$' would select just the subtest, its second level
+// applies to every test in the pattern, so it cannot share one -run with
+// the package's other failures. A panic or build error selects the whole
+// package: the failing test is unknown (panic) or nothing ran at all
+// (build error).
+func rerunCommands(tests []report.TestResult) []string {
+	byPkg := map[string]map[string]bool{}
+	whole := map[string]bool{}
+	for i := range tests {
+		t := &tests[i]
+		switch t.Outcome {
+		case report.OutcomePanic, report.OutcomeBuildError:
+			whole[t.Package] = true
+		case report.OutcomeFail:
+			if t.Test == "" {
+				whole[t.Package] = true
+				continue
+			}
+			top, _, _ := strings.Cut(t.Test, "/")
+			if byPkg[t.Package] == nil {
+				byPkg[t.Package] = map[string]bool{}
+			}
+			byPkg[t.Package][top] = true
+		default:
+		}
+	}
+
+	pkgs := make([]string, 0, len(byPkg)+len(whole))
+	for p := range whole {
+		pkgs = append(pkgs, p)
+	}
+	for p := range byPkg {
+		if !whole[p] {
+			pkgs = append(pkgs, p)
+		}
+	}
+	sort.Strings(pkgs)
+
+	out := make([]string, 0, len(pkgs))
+	for _, p := range pkgs {
+		if whole[p] {
+			out = append(out, "go test "+p)
+			continue
+		}
+		names := make([]string, 0, len(byPkg[p]))
+		for n := range byPkg[p] {
+			names = append(names, n)
+		}
+		sort.Strings(names)
+		out = append(out, fmt.Sprintf("go test -run '^(%s)$' %s", strings.Join(names, "|"), p))
+	}
+	return out
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dkoosis/fo/pkg/report"
+)
+
+func TestRerunCommands_GroupsByPackage(t *testing.T) {
+	t.Parallel()
+
+	got := rerunCommands([]report.TestResult{
+		{Package: "ex/b", Test: "TestZ", Outcome: report.OutcomeFail},
+		{Package: "ex/a", Test: "TestY/case_1", Outcome: report.OutcomeFail},
+		{Package: "ex/a", Test: "TestY", Outcome: report.OutcomeFail},
+		{Package: "ex/a", Test: "TestX", Outcome: report.OutcomeFail},
+		{Package: "ex/a", Test: "TestOK", Outcome: report.OutcomePass},
+		{Package: "ex/c", Outcome: report.OutcomeBuildError},
+	})
+	want := []string{
+		"go test -run '^(TestX|TestY)$' ex/a",
+		"go test -run '^(TestZ)$' ex/b",
+		"go test ex/c",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("rerunCommands =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestRunRerun_FromSnapshot(t *testing.T) {
+	seedSnapshot(t, &report.Report{Tests: []report.TestResult{
+		{Fingerprint: "cccc3333", Package: "ex/p", Test: "TestX", Outcome: report.OutcomeFail},
+	}})
+
+	var out, errBuf bytes.Buffer
+	if code := runRerun(nil, &out, &errBuf); code != 0 {
+		t.Fatalf("exit=%d stderr=%s", code, errBuf.String())
+	}
+	if got := out.String(); got != "go test -run '^(TestX)$' ex/p\n" {
+		t.Errorf("stdout = %q", got)
+	}
+}
+
+func TestRunRerun_FromGoTestJSONFile(t *testing.T) {
+	t.Parallel()
+
+	stream := `{"Action":"run","Package":"ex/p","Test":"TestA"}
+{"Action":"fail","Package":"ex/p","Test":"TestA","Elapsed":0}
+{"Action":"fail","Package":"ex/p","Elapsed":0.1}
+`
+	path := filepath.Join(t.TempDir(), "run.json")
+	if err := os.WriteFile(path, []byte(stream), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	var out, errBuf bytes.Buffer
+	if code := runRerun([]string{path}, &out, &errBuf); code != 0 {
+		t.Fatalf("exit=%d stderr=%s", code, errBuf.String())
+	}
+	if !strings.Contains(out.String(), "-run '^(TestA)$' ex/p") {
+		t.Errorf("stdout = %q", out.String())
+	}
+}
+
+func TestRunRerun_BadArgs(t *testing.T) {
+	t.Parallel()
+
+	for _, args := range [][]string{{"--bogus"}, {"a.json", "b.json"}} {
+		var out, errBuf bytes.Buffer
+		if code := runRerun(args, &out, &errBuf); code != 2 {
+			t.Errorf("runRerun(%q) exit=%d, want 2", args, code)
+		}
+	}
+}
+
+func TestRunRerun_NoPriorRun(t *testing.T) {
+	t.Setenv("FO_STATE_DIR", t.TempDir())
+
+	var out, errBuf bytes.Buffer
+	if code := runRerun(nil, &out, &errBuf); code != 2 {
+		t.Errorf("exit=%d, want 2", code)
+	}
+}
@@ -39,8 +39,8 @@ type streamOpts struct {
 	stdout    io.Writer
 	stderr    io.Writer
 	theme     theme.Theme
-	themeName string // only used by runStreamBatch's deferred renderMode
-	mode      string // only used by runStreamBatch
+	out       outputOpts // --theme/--color/--width; width sizes the live stream too
+	mode      string     // only used by runStreamBatch
 	stateFile string
 	policy    statePolicy
 }
@@ -61,7 +61,6 @@ func runStream(opts streamOpts) int {
 func runStreamCtx(ctx context.Context, opts streamOpts) int {
 	stdin, br, stdout, stderr := opts.stdin, opts.br, opts.stdout, opts.stderr
 	t, stateFile := opts.theme, opts.stateFile
-	width := termSize(stdout)
 
 	// Load suppression ruleset once for the run so streaming snapshots
 	// don't show findings the final summary will then drop (fo-2sk).
@@ -110,7 +109,9 @@ func runStreamCtx(ctx context.Context, opts streamOpts) int {
 		}
 	}()
 
-	renderErr := view.RenderStream(ctx, stdout, snapshots, t, width)
+	// Width is re-queried per snapshot (unless --width pins it) so a
+	// resized terminal gets correctly sized bars for the rest of the run.
+	renderErr := view.RenderStreamSized(ctx, stdout, snapshots, t, func() int { return opts.out.widthFor(stdout) }, view.ModeHuman)
 
 	// Wait for the producer. If ctx is already done (typical cancel/SIGINT
 	// path) give the producer a bounded grace window to finish I/O — long
@@ -188,7 +189,7 @@ func runStreamBatch(opts streamOpts) int {
 	saveErr := attachDiff(r, opts.stateFile, opts.policy, opts.stderr)
 	assignAndPersistIDs(r, opts.policy, opts.stderr)
 	recordRun(r, opts.policy, opts.stderr)
-	if err := renderMode(opts.mode, r, opts.stdout, opts.themeName, nil); err != nil {
+	if err := renderMode(opts.mode, r, opts.stdout, opts.out, nil); err != nil {
 		fmt.Fprintf(opts.stderr, "fo: %v\n", err)
 		return 2
 	}
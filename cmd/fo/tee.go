@@ -0,0 +1,45 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/dkoosis/fo/pkg/ansi"
+)
+
+// teeWriter sends rendered output to the terminal and a log file at once
+// (--tee). Format, theme, width, and VT decisions look through it to the
+// terminal via terminalOf, so the file never turns a TTY into a pipe.
+type teeWriter struct {
+	io.Writer // terminal + file
+
+	term io.Writer
+}
+
+// newTee copies term's output into file. Unless raw, the file copy has
+// its escapes removed so the log reads cleanly in an editor.
+func newTee(term, file io.Writer, raw bool) *teeWriter {
+	if !raw {
+		file = ansi.NewStripWriter(file)
+	}
+	return &teeWriter{Writer: io.MultiWriter(term, file), term: term}
+}
+
+// terminalOf is the writer a TTY probe should inspect: the terminal
+// behind a tee, or w itself.
+func terminalOf(w io.Writer) io.Writer {
+	if t, ok := w.(*teeWriter); ok {
+		return t.term
+	}
+	return w
+}
+
+// closeOutput closes a file opened for --output or --tee. A failed close
+// can lose the tail of the render, so it fails the run.
+func closeOutput(f *os.File, stderr io.Writer, code *int) {
+	if err := f.Close(); err != nil {
+		fmt.Fprintf(stderr, "fo: %v\n", err)
+		*code = 2
+	}
+}
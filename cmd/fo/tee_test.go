@@ -0,0 +1,42 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"testing"
+)
+
+func TestTee_StripsColorFromFileOnly(t *testing.T) {
+	t.Parallel()
+
+	var term, file bytes.Buffer
+	w := newTee(&term, &file, false)
+	if _, err := w.Write([]byte("\x1b[1;31mFAIL\x1b[0m TestX\n")); err != nil {
+		t.Fatal(err)
+	}
+	if got := term.String(); got != "\x1b[1;31mFAIL\x1b[0m TestX\n" {
+		t.Errorf("terminal = %q, want colors kept", got)
+	}
+	if got := file.String(); got != "FAIL TestX\n" {
+		t.Errorf("file = %q, want colors stripped", got)
+	}
+
+	var rawFile bytes.Buffer
+	raw := newTee(&bytes.Buffer{}, &rawFile, true)
+	_, _ = raw.Write([]byte("\x1b[31mx\x1b[0m"))
+	if got := rawFile.String(); got != "\x1b[31mx\x1b[0m" {
+		t.Errorf("raw file = %q, want escapes kept", got)
+	}
+}
+
+func TestTerminalOf_LooksThroughTee(t *testing.T) {
+	t.Parallel()
+
+	if got := terminalOf(newTee(os.Stderr, &bytes.Buffer{}, false)); got != os.Stderr {
+		t.Errorf("terminalOf(tee) = %v, want the terminal writer", got)
+	}
+	var b bytes.Buffer
+	if got := terminalOf(&b); got != &b {
+		t.Error("terminalOf should return a plain writer unchanged")
+	}
+}
@@ -1,27 +1,44 @@
 package main
 
 import (
+	"errors"
 	"flag"
 	"fmt"
 	"io"
+	"sort"
+	"strconv"
 	"time"
 
 	"github.com/dkoosis/fo/pkg/paint"
 	"github.com/dkoosis/fo/pkg/state"
 )
 
-// runTrend handles `fo trend <rule-id>` — it charts how often a rule has
-// fired across the recorded run history, so a regression that crept in
-// across a dozen individually-clean runs becomes visible as a rising line.
+// runTrend handles `fo trend [--scale] <rule-id>` — it charts how often a
+// rule has fired across the recorded run history, so a regression that
+// crept in across a dozen individually-clean runs becomes visible as a
+// rising line. --scale appends the sparkline's min/max/last so its height
+// reads as a count. Flags may follow the rule id.
 func runTrend(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("fo trend", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "usage: fo trend [--scale] <rule-id>   (charts a rule's count across recorded runs)")
+	}
+	scale := fs.Bool("scale", false, "Append the sparkline's min, max, and last count")
 	rule := ""
-	for _, a := range args {
-		if a == "-h" || a == flagHelp {
-			fmt.Fprintln(stderr, "usage: fo trend <rule-id>   (charts a rule's count across recorded runs)")
-			return 0
+	for len(args) > 0 {
+		if err := fs.Parse(args); err != nil {
+			if errors.Is(err, flag.ErrHelp) {
+				return 0
+			}
+			return 2
 		}
-		if rule == "" && a != "" && a[0] != '-' {
-			rule = a
+		args = fs.Args()
+		if len(args) > 0 {
+			if rule == "" {
+				rule = args[0]
+			}
+			args = args[1:]
 		}
 	}
 	if rule == "" {
@@ -52,21 +69,37 @@ func runTrend(args []string, stdout, stderr io.Writer) int {
 	}
 
 	first, last := series[0], series[len(series)-1]
-	fmt.Fprintf(stdout, "%s  %s  %s\n", rule, paint.Sparkline(values), trendArrow(first, last))
+	spark := paint.Sparkline(values)
+	if *scale {
+		spark += "  " + paint.SparkScale(values, true, true, func(v float64) string { return strconv.FormatFloat(v, 'f', -1, 64) })
+	}
+	fmt.Fprintf(stdout, "%s  %s  %s\n", rule, spark, trendArrow(first, last))
 	fmt.Fprintf(stdout, "runs %d  first %d  last %d  peak %d\n", len(series), first, last, maxInt(series))
 	return 0
 }
 
-// runReplay handles `fo replay [--since=<dur>]` — it lists recent runs with
-// their headline counts so a reader can see the shape of activity over time
-// without re-running anything.
+// runReplay handles `fo replay [--since=<dur>] [--lines=N] [--offset=M]
+// [--diff]` — it lists recent runs with their headline counts so a reader
+// can see the shape of activity over time without re-running anything.
+// --lines and --offset window the (since-filtered) list so a long history
+// doesn't scroll off screen. --diff replaces the listing with the per-rule
+// count changes between the last run of that window and the previous run
+// of the same tool in it, since rule sets from different tools never
+// overlap.
 func runReplay(args []string, stdout, stderr io.Writer) int {
 	fs := flag.NewFlagSet("fo replay", flag.ContinueOnError)
 	fs.SetOutput(stderr)
 	since := fs.Duration("since", 0, "Only show runs newer than this (e.g. 1h, 30m); 0 = all")
+	lines := fs.Int("lines", 0, "Show at most N runs; 0 = all")
+	offset := fs.Int("offset", 0, "Skip the first M runs before showing --lines")
+	diff := fs.Bool("diff", false, "Show per-rule count changes between the last run shown and the previous run of the same tool")
 	if err := fs.Parse(args); err != nil {
 		return 2
 	}
+	if *lines < 0 || *offset < 0 {
+		fmt.Fprintln(stderr, "fo replay: --lines and --offset must be >= 0")
+		return 2
+	}
 
 	rl, err := state.LoadRunLog(state.RunLogPath())
 	if err != nil {
@@ -79,18 +112,91 @@ func runReplay(args []string, stdout, stderr io.Writer) int {
 	}
 
 	cutoff := replayCutoff(*since, rl.Entries[len(rl.Entries)-1].At)
-	shown := 0
+	var matched []*state.RunLogEntry
 	for i := range rl.Entries {
-		e := &rl.Entries[i]
-		if !e.At.Before(cutoff) {
-			fmt.Fprintln(stdout, replayLine(e))
-			shown++
+		if e := &rl.Entries[i]; !e.At.Before(cutoff) {
+			matched = append(matched, e)
 		}
 	}
-	if shown == 0 {
+	if len(matched) == 0 {
 		fmt.Fprintf(stderr, "fo replay: no runs within the last %s\n", *since)
 		return 2
 	}
+	lo, hi := replayWindow(len(matched), *offset, *lines)
+	if lo == hi {
+		fmt.Fprintf(stderr, "fo replay: --offset %d is past the last of %d run(s)\n", *offset, len(matched))
+		return 2
+	}
+	if *diff {
+		from, to := replayDiffPair(matched[lo:hi])
+		return replayDiff(from, to, stdout, stderr)
+	}
+	for _, e := range matched[lo:hi] {
+		fmt.Fprintln(stdout, replayLine(e))
+	}
+	if hi-lo < len(matched) {
+		fmt.Fprintf(stdout, "(showing runs %d–%d of %d)\n", lo+1, hi, len(matched))
+	}
+	return 0
+}
+
+// replayWindow clamps an offset/limit pair to [0, n). A zero limit means
+// everything after the offset.
+func replayWindow(n, offset, limit int) (lo, hi int) {
+	lo = min(offset, n)
+	hi = n
+	if limit > 0 {
+		hi = min(lo+limit, n)
+	}
+	return lo, hi
+}
+
+// replayDiffPair picks the runs --diff compares: the newest in window and
+// the latest earlier run with the same tool, or nil when there is none.
+func replayDiffPair(window []*state.RunLogEntry) (from, to *state.RunLogEntry) {
+	to = window[len(window)-1]
+	for i := len(window) - 2; i >= 0; i-- {
+		if window[i].Tool == to.Tool {
+			return window[i], to
+		}
+	}
+	return nil, to
+}
+
+// replayDiff prints one line per rule whose count differs between two
+// runs, sorted by rule ID. Rules absent from a run count as zero.
+func replayDiff(from, to *state.RunLogEntry, stdout, stderr io.Writer) int {
+	if from == nil {
+		tool := to.Tool
+		if tool == "" {
+			tool = "-"
+		}
+		fmt.Fprintf(stderr, "fo replay: --diff needs an earlier %s run in the window to compare against\n", tool)
+		return 2
+	}
+	fmt.Fprintf(stdout, "%s → %s\n", from.At.Format("2006-01-02 15:04:05"), to.At.Format("2006-01-02 15:04:05"))
+	var rules []string
+	for r := range from.RuleCounts {
+		rules = append(rules, r)
+	}
+	for r := range to.RuleCounts {
+		if _, seen := from.RuleCounts[r]; !seen {
+			rules = append(rules, r)
+		}
+	}
+	sort.Strings(rules)
+	changed := 0
+	for _, r := range rules {
+		a, b := from.RuleCounts[r], to.RuleCounts[r]
+		if a == b {
+			continue
+		}
+		fmt.Fprintf(stdout, "%-24s %d → %d  %+d\n", r, a, b, b-a)
+		changed++
+	}
+	if changed == 0 {
+		fmt.Fprintln(stdout, "no rule-count changes")
+	}
 	return 0
 }
 
@@ -97,3 +97,94 @@ func TestRunReplay_AllWhenNoSince(t *testing.T) {
 		t.Errorf("want 2 runs listed, got %d lines", n)
 	}
 }
+
+func TestRunReplay_LinesAndOffsetWindow(t *testing.T) {
+	now := time.Date(2026, 6, 15, 12, 0, 0, 0, time.UTC)
+	seedRunLog(t,
+		state.RunLogEntry{At: now.Add(-3 * time.Minute), Tool: "r1"},
+		state.RunLogEntry{At: now.Add(-2 * time.Minute), Tool: "r2"},
+		state.RunLogEntry{At: now.Add(-1 * time.Minute), Tool: "r3"},
+		state.RunLogEntry{At: now, Tool: "r4"},
+	)
+	var out, errBuf bytes.Buffer
+	if code := runReplay([]string{"--offset=1", "--lines=2"}, &out, &errBuf); code != 0 {
+		t.Fatalf("exit=%d stderr=%s", code, errBuf.String())
+	}
+	got := out.String()
+	for _, want := range []string{"r2", "r3", "(showing runs 2–3 of 4)"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("missing %q in:\n%s", want, got)
+		}
+	}
+	for _, gone := range []string{"r1", "r4"} {
+		if strings.Contains(got, gone) {
+			t.Errorf("%s is outside the window:\n%s", gone, got)
+		}
+	}
+}
+
+func TestRunReplay_OffsetPastEnd(t *testing.T) {
+	seedRunLog(t, state.RunLogEntry{At: time.Now(), Tool: "only"})
+	var out, errBuf bytes.Buffer
+	if code := runReplay([]string{"--offset=5"}, &out, &errBuf); code != 2 {
+		t.Errorf("offset past end: want exit 2, got %d", code)
+	}
+	if !strings.Contains(errBuf.String(), "past the last") {
+		t.Errorf("want 'past the last', got %q", errBuf.String())
+	}
+}
+
+func TestRunReplay_DiffRuleCounts(t *testing.T) {
+	now := time.Date(2026, 6, 15, 12, 0, 0, 0, time.UTC)
+	seedRunLog(t,
+		state.RunLogEntry{At: now.Add(-time.Hour), RuleCounts: map[string]int{"SA1000": 1, "errcheck": 3, "gone": 2}},
+		state.RunLogEntry{At: now, RuleCounts: map[string]int{"SA1000": 4, "errcheck": 3, "new": 1}},
+	)
+	var out, errBuf bytes.Buffer
+	if code := runReplay([]string{"--diff"}, &out, &errBuf); code != 0 {
+		t.Fatalf("exit=%d stderr=%s", code, errBuf.String())
+	}
+	got := out.String()
+	for _, want := range []string{"1 → 4  +3", "2 → 0  -2", "0 → 1  +1"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("missing %q in:\n%s", want, got)
+		}
+	}
+	if strings.Contains(got, "errcheck") {
+		t.Errorf("unchanged rule should be omitted:\n%s", got)
+	}
+}
+
+func TestRunReplay_DiffComparesRunsOfTheSameTool(t *testing.T) {
+	now := time.Date(2026, 6, 15, 12, 0, 0, 0, time.UTC)
+	seedRunLog(t,
+		state.RunLogEntry{At: now.Add(-3 * time.Hour), Tool: "golangci-lint", RuleCounts: map[string]int{"SA1000": 1}},
+		state.RunLogEntry{At: now.Add(-2 * time.Hour), Tool: "go test", RuleCounts: map[string]int{}},
+		state.RunLogEntry{At: now.Add(-time.Hour), Tool: "golangci-lint", RuleCounts: map[string]int{"SA1000": 2}},
+		state.RunLogEntry{At: now, Tool: "go test", RuleCounts: map[string]int{}},
+	)
+	var out, errBuf bytes.Buffer
+	if code := runReplay([]string{"--diff"}, &out, &errBuf); code != 0 {
+		t.Fatalf("exit=%d stderr=%s", code, errBuf.String())
+	}
+	if got := out.String(); strings.Contains(got, "SA1000") || !strings.Contains(got, "no rule-count changes") {
+		t.Errorf("go test runs should diff against each other, not golangci-lint:\n%s", got)
+	}
+
+	out.Reset()
+	errBuf.Reset()
+	if code := runReplay([]string{"--diff", "--offset=1", "--lines=2"}, &out, &errBuf); code != 2 {
+		t.Errorf("no earlier same-tool run in window: want exit 2, got %d (out=%q)", code, out.String())
+	}
+	if !strings.Contains(errBuf.String(), "earlier golangci-lint run") {
+		t.Errorf("stderr = %q, want it to name the tool", errBuf.String())
+	}
+}
+
+func TestRunReplay_DiffNeedsTwoRuns(t *testing.T) {
+	seedRunLog(t, state.RunLogEntry{At: time.Now()})
+	var out, errBuf bytes.Buffer
+	if code := runReplay([]string{"--diff"}, &out, &errBuf); code != 2 {
+		t.Errorf("single run: want exit 2, got %d", code)
+	}
+}
@@ -11,8 +11,13 @@ import (
 	"os"
 	"os/exec"
 	"os/signal"
+	"runtime"
+	"strings"
+	"sync"
 	"syscall"
 	"time"
+
+	"github.com/dkoosis/fo/pkg/theme"
 )
 
 var errWatchUsage = errors.New("usage: fo watch [flags] -- <command> [args...]")
@@ -20,10 +25,22 @@ var errWatchUsage = errors.New("usage: fo watch [flags] -- <command> [args...]")
 // sourceStdin is the watch-trigger source value selecting stdin newlines.
 const sourceStdin = "stdin"
 
+// -notify values. notifyOSMin is how long a run must take before "os"
+// raises a desktop notification; quicker runs finish while the user is
+// still looking, so only the bell sounds.
+const (
+	notifyBell  = "bell"
+	notifyOS    = "os"
+	notifyOSMin = 10 * time.Second
+)
+
 // watchOpts are flags accepted before `--` in `fo watch`.
 type watchOpts struct {
 	debounce time.Duration
 	source   string // "fs" (default) or "stdin"
+	timeout  time.Duration
+	spinner  string // named theme spinner style; "" keeps the theme's own
+	notify   string // "" (off), "bell", or "os"
 }
 
 // parseWatchArgs splits watch args at the `--` separator. Flags before `--`
@@ -55,12 +72,22 @@ func parseWatchArgsWithOpts(args []string) ([]string, watchOpts, error) {
 	fs.SetOutput(io.Discard)
 	fs.DurationVar(&opts.debounce, "debounce", opts.debounce, "coalesce burst events within this window")
 	fs.StringVar(&opts.source, "source", opts.source, "trigger source: fs|stdin")
+	fs.DurationVar(&opts.timeout, "timeout", 0, "kill a run's command after this long (0 = no limit)")
+	fs.StringVar(&opts.spinner, "spinner", "", "progress spinner style: "+strings.Join(theme.SpinnerStyleNames(), "|"))
+	fs.StringVar(&opts.notify, "notify", "", "on run completion: bell, or os (bell + desktop notification for runs over 10s)")
 	if err := fs.Parse(flagArgs); err != nil {
 		return nil, watchOpts{}, fmt.Errorf("watch: %w", err)
 	}
 	if opts.source != "fs" && opts.source != sourceStdin {
 		return nil, watchOpts{}, fmt.Errorf("%w: -source must be fs or stdin", errWatchUsage)
 	}
+	if opts.notify != "" && opts.notify != notifyBell && opts.notify != notifyOS {
+		return nil, watchOpts{}, fmt.Errorf("%w: -notify must be bell or os", errWatchUsage)
+	}
+	if _, ok := theme.SpinnerStyle(opts.spinner); opts.spinner != "" && !ok {
+		return nil, watchOpts{}, fmt.Errorf("%w: -spinner must be one of %s",
+			errWatchUsage, strings.Join(theme.SpinnerStyleNames(), ", "))
+	}
 	return cmd, opts, nil
 }
 
@@ -103,6 +130,11 @@ func runWatch(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
 	// Keyboard control is offered only for the fs trigger source. The stdin
 	// source consumes newlines as triggers and is mutually exclusive with
 	// raw-mode keypress reads on the same descriptor.
+	// Settle the auto theme's background query (cached for the process)
+	// before raw mode: once keyControl's reader owns the terminal, the
+	// reply would arrive as keypresses and the query would stall.
+	_ = resolveTheme("auto", colorAuto, stdout)
+
 	var keyTriggers <-chan struct{}
 	restoreTTY := func() {}
 	if opts.source != sourceStdin {
@@ -127,13 +159,27 @@ func runWatch(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
 	}
 
 	isTTY := isTTYWriter(stdout)
+	// The running line lives on stderr, next to the child's own stderr
+	// that it yields to; it needs that stream to be a live terminal.
+	var spinner string
+	if isTTYWriter(stderr) {
+		color, _ := resolveColor(colorAuto)
+		spinner = watchSpinner(color == colorNever, opts.spinner)
+	}
 	var lastCode int
 	var runN int
 	runOnce := func() {
 		runN++
 		started := time.Now()
-		lastCode = runChildAndRender(ctx, cmd, stdout, stderr)
-		writeWatchStatus(stdout, isTTY, runN, started, time.Since(started), lastCode)
+		runCtx, cancel := ctx, func() {}
+		if opts.timeout > 0 {
+			runCtx, cancel = context.WithTimeout(ctx, opts.timeout)
+		}
+		lastCode = runChildAndRender(runCtx, cmd, stdout, stderr, spinner)
+		cancel()
+		dur := time.Since(started)
+		writeWatchStatus(stdout, isTTY, runN, started, dur, lastCode)
+		notifyDone(ctx, stdout, opts.notify, isTTY, cmd[0], lastCode, dur)
 	}
 	between := func() {
 		if isTTY {
@@ -147,6 +193,59 @@ func runWatch(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
 	return lastCode
 }
 
+// watchSpinner picks the progress frames: the named --spinner style when
+// given, else the auto theme's own. Only mono vs. color matters here, so
+// the caller settles it from --color's environment rules alone rather
+// than the full theme pick, whose background query would write to the
+// terminal. Mono keeps its ASCII frames regardless, since it is the
+// preset for terminals that may not render the unicode styles.
+func watchSpinner(mono bool, style string) string {
+	if mono {
+		return theme.Mono().Icons.Spinner
+	}
+	if frames, ok := theme.SpinnerStyle(style); ok {
+		return frames
+	}
+	return theme.Color().Icons.Spinner
+}
+
+// notifyDone signals a finished run. The bell goes to a TTY only, where
+// it is a sound rather than a stray control byte in a log. "os" also
+// raises a desktop notification for runs of notifyOSMin or longer; a
+// missing notifier binary is not an error worth surfacing.
+func notifyDone(ctx context.Context, w io.Writer, mode string, isTTY bool, name string, code int, dur time.Duration) {
+	if mode == "" {
+		return
+	}
+	if isTTY {
+		fmt.Fprint(w, "\a")
+	}
+	if mode != notifyOS || dur < notifyOSMin {
+		return
+	}
+	argv := notifyCommand(runtime.GOOS, "fo watch", fmt.Sprintf("%s finished: exit %d (%s)", name, code, dur.Round(time.Second)))
+	if argv == nil {
+		return
+	}
+	_ = exec.CommandContext(ctx, argv[0], argv[1:]...).Run() //nolint:gosec // fixed notifier binary; message is fo-generated
+}
+
+// notifyCommand returns the desktop-notification argv for goos, or nil
+// where fo knows no notifier.
+func notifyCommand(goos, title, body string) []string {
+	switch goos {
+	case "darwin":
+		q := func(s string) string {
+			return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+		}
+		return []string{"osascript", "-e", "display notification " + q(body) + " with title " + q(title)}
+	case "linux", "freebsd", "openbsd":
+		return []string{"notify-send", title, body}
+	default:
+		return nil
+	}
+}
+
 // writeWatchStatus prints a one-line trailer after each rerun showing
 // run-number, completion time, duration, exit code. Trailer-not-header
 // keeps it out of the way for piped/non-TTY consumers and avoids hiding
@@ -206,8 +305,13 @@ func stdinTriggers(ctx context.Context, r io.Reader) <-chan struct{} {
 // runChildAndRender executes cmd, captures its stdout, and renders it
 // through fo's existing pipeline. Child stderr passes through to stderr.
 // Returns the render exit code; child non-zero exit is normal (e.g. test
-// failures) and does not short-circuit rendering.
-func runChildAndRender(ctx context.Context, cmd []string, stdout, stderr io.Writer) int {
+// failures) and does not short-circuit rendering. A child killed by a ctx
+// deadline (watch --timeout) is an fo error: its partial output is not
+// rendered, since a truncated run would read as a clean one. A non-empty
+// spinner animates a "running" line on stderr while the child works; the
+// child's stderr is routed through it so warnings are never glued to or
+// erased by the animation, and the line is gone before the render.
+func runChildAndRender(ctx context.Context, cmd []string, stdout, stderr io.Writer, spinner string) int {
 	if len(cmd) == 0 {
 		return 2
 	}
@@ -215,11 +319,127 @@ func runChildAndRender(ctx context.Context, cmd []string, stdout, stderr io.Writ
 	// G204: cmd is the user-supplied argv after `fo watch -- ...`.
 	// Executing arbitrary commands IS the feature; the user is the one typing it.
 	c := exec.CommandContext(ctx, cmd[0], cmd[1:]...) //nolint:gosec // user-supplied command is the contract
+	passthrough, stop := startProgress(stderr, spinner, "running "+cmd[0])
 	c.Stdout = &buf
-	c.Stderr = stderr
-	_ = c.Run() // child non-zero is expected (test failures, lint findings)
+	c.Stderr = passthrough
+	// A grandchild holding the stdout pipe would otherwise keep Wait
+	// blocked past the kill.
+	c.WaitDelay = time.Second
+	runErr := c.Run() // child non-zero is expected (test failures, lint findings)
+	stop()
+	if killedByDeadline(ctx, c, runErr) {
+		fmt.Fprintf(stderr, "fo: watch: %s timed out\n", cmd[0])
+		return 2
+	}
 	if buf.Len() == 0 {
 		return 0
 	}
 	return run(nil, &buf, stdout, stderr)
 }
+
+// killedByDeadline reports whether the deadline is what ended the child.
+// ctx expiring is not enough: a child that exited on its own just before
+// the deadline has complete output and must be rendered. Killed means Run
+// failed and the process died by signal (ExitCode -1), not by exiting.
+func killedByDeadline(ctx context.Context, c *exec.Cmd, runErr error) bool {
+	if runErr == nil || !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return false
+	}
+	return c.ProcessState != nil && c.ProcessState.ExitCode() == -1
+}
+
+// startProgress redraws "<frame> <label> (<elapsed>)" in place on w until
+// the returned stop is called; elapsed appears once a full second has
+// passed, so fast runs only ever show the bare label. Other output bound
+// for w must go through the returned passthrough writer, which erases the
+// line before each write and holds redraws while that output sits
+// mid-line. stop erases the line and returns only once the animation
+// goroutine has exited, so nothing it writes can interleave with the
+// render that follows. Empty frames disable it and passthrough is w
+// itself: callers pass frames only on a TTY, where a \r redraw is a live
+// line rather than log noise.
+func startProgress(w io.Writer, frames, label string) (passthrough io.Writer, stop func()) {
+	runes := []rune(frames)
+	if len(runes) == 0 {
+		return w, func() {}
+	}
+	p := &progressLine{w: w}
+	start := time.Now()
+	frame := func(i int) string {
+		return fmt.Sprintf("%c %s%s", runes[i%len(runes)], label, elapsedSuffix(time.Since(start)))
+	}
+	p.draw(frame(0))
+	done := make(chan struct{})
+	exited := make(chan struct{})
+	go func() {
+		defer close(exited)
+		tick := time.NewTicker(100 * time.Millisecond)
+		defer tick.Stop()
+		for i := 1; ; i++ {
+			select {
+			case <-done:
+				p.erase()
+				return
+			case <-tick.C:
+			}
+			p.draw(frame(i))
+		}
+	}()
+	return p, func() {
+		close(done)
+		<-exited
+	}
+}
+
+// progressLine serializes the running line with the output it shares a
+// terminal with. drawn is whether the line is on screen; midLine is set
+// while passthrough output ends without a newline, when a \r redraw
+// would overwrite it.
+type progressLine struct {
+	mu      sync.Mutex
+	w       io.Writer
+	drawn   bool
+	midLine bool
+}
+
+func (p *progressLine) draw(line string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.midLine {
+		return
+	}
+	fmt.Fprint(p.w, "\r\x1b[K"+line)
+	p.drawn = true
+}
+
+func (p *progressLine) erase() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.eraseLocked()
+}
+
+func (p *progressLine) eraseLocked() {
+	if p.drawn {
+		fmt.Fprint(p.w, "\r\x1b[K")
+		p.drawn = false
+	}
+}
+
+func (p *progressLine) Write(b []byte) (int, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.eraseLocked()
+	if len(b) > 0 {
+		p.midLine = b[len(b)-1] != '\n'
+	}
+	return p.w.Write(b)
+}
+
+// elapsedSuffix is the " (12s)" tail of the progress line, whole seconds
+// only, or "" under a second.
+func elapsedSuffix(d time.Duration) string {
+	if d < time.Second {
+		return ""
+	}
+	return " (" + d.Truncate(time.Second).String() + ")"
+}
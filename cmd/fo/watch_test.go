@@ -9,6 +9,8 @@ import (
 	"sync/atomic"
 	"testing"
 	"time"
+
+	"github.com/dkoosis/fo/pkg/theme"
 )
 
 const (
@@ -16,6 +18,45 @@ const (
 	testArg = "test"
 )
 
+func TestWatchSpinner(t *testing.T) {
+	braille, _ := theme.SpinnerStyle("braille")
+	if got := watchSpinner(false, "braille"); got != braille {
+		t.Errorf("color + braille = %q, want %q", got, braille)
+	}
+	if got := watchSpinner(false, ""); got != theme.Color().Icons.Spinner {
+		t.Errorf("no style should keep the theme's frames, got %q", got)
+	}
+	if got := watchSpinner(true, "braille"); got != theme.Mono().Icons.Spinner {
+		t.Errorf("mono should ignore --spinner, got %q", got)
+	}
+}
+
+func TestNotifyDone_BellOnTTYOnly(t *testing.T) {
+	var tty, pipe, off bytes.Buffer
+	notifyDone(context.Background(), &tty, notifyBell, true, "go", 1, time.Second)
+	notifyDone(context.Background(), &pipe, notifyBell, false, "go", 1, time.Second)
+	notifyDone(context.Background(), &off, "", true, "go", 1, time.Second)
+	if tty.String() != "\a" {
+		t.Errorf("TTY bell = %q, want \\a", tty.String())
+	}
+	if pipe.Len() != 0 || off.Len() != 0 {
+		t.Errorf("bell leaked: pipe=%q off=%q", pipe.String(), off.String())
+	}
+}
+
+func TestNotifyCommand(t *testing.T) {
+	got := notifyCommand("darwin", "fo watch", `say "hi"`)
+	if len(got) != 3 || got[0] != "osascript" || !strings.Contains(got[2], `"say \"hi\""`) {
+		t.Errorf("darwin argv = %q", got)
+	}
+	if got := notifyCommand("linux", "t", "b"); strings.Join(got, " ") != "notify-send t b" {
+		t.Errorf("linux argv = %q", got)
+	}
+	if got := notifyCommand("windows", "t", "b"); got != nil {
+		t.Errorf("windows argv = %q, want nil", got)
+	}
+}
+
 func TestParseWatchArgs(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -28,6 +69,11 @@ func TestParseWatchArgs(t *testing.T) {
 		{"separator only", []string{"--"}, nil, true},
 		{"basic", []string{"--", echoCmd, "hi"}, []string{echoCmd, "hi"}, false},
 		{"flag before separator", []string{"-debounce=200ms", "--", "go", testArg, "./..."}, []string{"go", testArg, "./..."}, false},
+		{"timeout flag", []string{"-timeout=30s", "--", "go", testArg}, []string{"go", testArg}, false},
+		{"spinner flag", []string{"-spinner=braille", "--", "go", testArg}, []string{"go", testArg}, false},
+		{"unknown spinner", []string{"-spinner=wave", "--", "go", testArg}, nil, true},
+		{"notify flag", []string{"-notify=bell", "--", "go", testArg}, []string{"go", testArg}, false},
+		{"unknown notify", []string{"-notify=email", "--", "go", testArg}, nil, true},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -135,7 +181,7 @@ func TestRunChildAndRender_RendersChildStdout(t *testing.T) {
 	var stdout, stderr bytes.Buffer
 	cmd := []string{"sh", "-c", "printf '%s' " + shellQuote(event)}
 
-	code := runChildAndRender(context.Background(), cmd, &stdout, &stderr)
+	code := runChildAndRender(context.Background(), cmd, &stdout, &stderr, "")
 
 	if code != 0 {
 		t.Fatalf("runChildAndRender: want exit 0 (all PASS), got %d (stderr=%q)", code, stderr.String())
@@ -145,6 +191,23 @@ func TestRunChildAndRender_RendersChildStdout(t *testing.T) {
 	}
 }
 
+func TestRunChildAndRender_SpinnerStyleStaysOffStdout(t *testing.T) {
+	frames, _ := theme.SpinnerStyle("braille")
+	var stdout, stderr bytes.Buffer
+	cmd := []string{"sh", "-c", "echo 'warning: slow' >&2"}
+	if code := runChildAndRender(context.Background(), cmd, &stdout, &stderr, frames); code != 0 {
+		t.Fatalf("runChildAndRender: exit %d", code)
+	}
+	first := string([]rune(frames)[0])
+	if strings.Contains(stdout.String(), first) {
+		t.Errorf("spinner frame leaked into stdout: %q", stdout.String())
+	}
+	got := stderr.String()
+	if !strings.Contains(got, first+" running sh") || !strings.Contains(got, "\r\x1b[Kwarning: slow\n") {
+		t.Errorf("stderr = %q, want the styled running line erased before the child's warning", got)
+	}
+}
+
 func TestRunChildAndRender_FailingTestExitsNonZero(t *testing.T) {
 	const event = `{"Time":"2024-01-01T00:00:00Z","Action":"fail","Package":"x","Test":"TestA","Elapsed":0.01}` + "\n" +
 		`{"Time":"2024-01-01T00:00:00Z","Action":"fail","Package":"x","Elapsed":0.01}` + "\n"
@@ -152,7 +215,7 @@ func TestRunChildAndRender_FailingTestExitsNonZero(t *testing.T) {
 	var stdout, stderr bytes.Buffer
 	cmd := []string{"sh", "-c", "printf '%s' " + shellQuote(event) + "; exit 1"}
 
-	code := runChildAndRender(context.Background(), cmd, &stdout, &stderr)
+	code := runChildAndRender(context.Background(), cmd, &stdout, &stderr, "")
 	if code == 0 {
 		t.Fatalf("runChildAndRender: want non-zero exit on test failure, got 0 (stdout=%q stderr=%q)", stdout.String(), stderr.String())
 	}
@@ -161,7 +224,7 @@ func TestRunChildAndRender_FailingTestExitsNonZero(t *testing.T) {
 func TestRunChildAndRender_EmptyChildOutputIsClean(t *testing.T) {
 	var stdout, stderr bytes.Buffer
 	cmd := []string{"sh", "-c", "true"}
-	code := runChildAndRender(context.Background(), cmd, &stdout, &stderr)
+	code := runChildAndRender(context.Background(), cmd, &stdout, &stderr, "")
 	if code != 0 {
 		t.Fatalf("runChildAndRender: empty child output should exit 0, got %d", code)
 	}
@@ -286,3 +349,94 @@ func equalSlice(a, b []string) bool {
 func shellQuote(s string) string {
 	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
 }
+
+func TestRunChildAndRender_TimeoutIsError(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	var stdout, stderr bytes.Buffer
+	cmd := []string{"sh", "-c", "sleep 5"}
+	started := time.Now()
+	code := runChildAndRender(ctx, cmd, &stdout, &stderr, "")
+	if code != 2 {
+		t.Fatalf("runChildAndRender: want exit 2 on timeout, got %d", code)
+	}
+	if !strings.Contains(stderr.String(), "timed out") {
+		t.Errorf("stderr should report the timeout, got %q", stderr.String())
+	}
+	if time.Since(started) > 3*time.Second {
+		t.Errorf("timed-out child was not killed promptly")
+	}
+}
+
+// expiredCtx reports a passed deadline without ever firing Done, standing
+// in for a deadline that lapses between the child's exit and the check.
+type expiredCtx struct{ context.Context }
+
+func (expiredCtx) Err() error { return context.DeadlineExceeded }
+
+func TestRunChildAndRender_ExitBeforeDeadlineIsRendered(t *testing.T) {
+	const event = `{"Time":"2024-01-01T00:00:00Z","Action":"pass","Package":"x","Test":"TestA","Elapsed":0.01}` + "\n" +
+		`{"Time":"2024-01-01T00:00:00Z","Action":"pass","Package":"x","Elapsed":0.01}` + "\n"
+	var stdout, stderr bytes.Buffer
+	cmd := []string{"sh", "-c", "printf '%s' " + shellQuote(event) + "; exit 1"}
+	code := runChildAndRender(expiredCtx{context.Background()}, cmd, &stdout, &stderr, "")
+	if strings.Contains(stderr.String(), "timed out") {
+		t.Errorf("a child that exited on its own was reported as timed out: %q", stderr.String())
+	}
+	if code != 0 || stdout.Len() == 0 {
+		t.Errorf("want the child's output rendered (exit 0), got exit %d, stdout=%q", code, stdout.String())
+	}
+}
+
+func TestStartProgress_ErasesLineOnStop(t *testing.T) {
+	var buf bytes.Buffer
+	_, stop := startProgress(&buf, `|/-\`, "running go")
+	stop()
+	got := buf.String()
+	if !strings.Contains(got, "| running go") {
+		t.Errorf("progress line not drawn: %q", got)
+	}
+	if !strings.HasSuffix(got, "\r\x1b[K") {
+		t.Errorf("progress line not erased on stop: %q", got)
+	}
+
+	buf.Reset()
+	pass, stop := startProgress(&buf, "", "running go")
+	stop()
+	if buf.Len() != 0 || pass != io.Writer(&buf) {
+		t.Errorf("empty frames should draw nothing and pass w through, got %q", buf.String())
+	}
+}
+
+func TestStartProgress_PassthroughNeverSharesTheLine(t *testing.T) {
+	var buf bytes.Buffer
+	pass, stop := startProgress(&buf, `|/-\`, "running go")
+	_, _ = pass.Write([]byte("warning: deprecated\n"))
+	_, _ = pass.Write([]byte("partial"))
+	stop()
+	got := buf.String()
+	// The spinner line is erased before the child's line, so the warning
+	// starts on a clean line rather than after "running go".
+	if !strings.Contains(got, "running go\r\x1b[Kwarning: deprecated\n") {
+		t.Errorf("passthrough not preceded by an erase: %q", got)
+	}
+	// After a write that stops mid-line, no \r redraw may clobber it.
+	if !strings.HasSuffix(got, "partial") {
+		t.Errorf("mid-line passthrough was overwritten: %q", got)
+	}
+}
+
+func TestElapsedSuffix(t *testing.T) {
+	for _, c := range []struct {
+		d    time.Duration
+		want string
+	}{
+		{400 * time.Millisecond, ""},
+		{12*time.Second + 700*time.Millisecond, " (12s)"},
+		{65 * time.Second, " (1m5s)"},
+	} {
+		if got := elapsedSuffix(c.d); got != c.want {
+			t.Errorf("elapsedSuffix(%v) = %q, want %q", c.d, got, c.want)
+		}
+	}
+}
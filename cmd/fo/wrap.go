@@ -6,6 +6,7 @@ import (
 	"flag"
 	"fmt"
 	"io"
+	"os"
 	"strings"
 
 	"github.com/dkoosis/fo/pkg/wrapper/wraparchlint"
@@ -13,23 +14,35 @@ import (
 	"github.com/dkoosis/fo/pkg/wrapper/wrapcover"
 	"github.com/dkoosis/fo/pkg/wrapper/wrapcoverprofile"
 	"github.com/dkoosis/fo/pkg/wrapper/wrapdiag"
+	"github.com/dkoosis/fo/pkg/wrapper/wrapdocker"
+	"github.com/dkoosis/fo/pkg/wrapper/wraperrcheck"
 	"github.com/dkoosis/fo/pkg/wrapper/wrapgobench"
+	"github.com/dkoosis/fo/pkg/wrapper/wrapgomod"
+	"github.com/dkoosis/fo/pkg/wrapper/wrapgovet"
 	"github.com/dkoosis/fo/pkg/wrapper/wrapjscpd"
+	"github.com/dkoosis/fo/pkg/wrapper/wrapkubectl"
 	"github.com/dkoosis/fo/pkg/wrapper/wrapleaderboard"
+	"github.com/dkoosis/fo/pkg/wrapper/wrapslowtests"
 )
 
 // wrapNames is the canonical list of `fo wrap` subcommands.
-var wrapNames = []string{"archlint", "archlint-text", "cover", "coverprofile", "diag", "gobench", "jscpd", "leaderboard"}
+var wrapNames = []string{"archlint", "archlint-text", "cover", "coverprofile", "diag", "docker", "errcheck", "gobench", "gomod", "govet", "jscpd", "kubectl", "leaderboard", "slowtests"}
 
 var wrapDescriptions = map[string]string{
 	"archlint":      "Convert go-arch-lint JSON to SARIF",
 	"archlint-text": "Convert go-arch-lint plain-text output to SARIF",
 	"cover":         "Convert `go tool cover -func` output to fo:metrics",
-	"coverprofile":  "Convert a `-coverprofile` file to SARIF (note per uncovered block)",
+	"coverprofile":  "Convert a `-coverprofile` file to SARIF (note per uncovered block), or per-package fo:metrics with --by-package",
 	"diag":          "Convert line diagnostics (file:line:col: msg) to SARIF",
-	"gobench":       "Convert raw `go test -bench` output to fo:metrics",
+	"docker":        "Convert `docker build` output (classic or --progress=plain) to fo:status, one row per step",
+	"errcheck":      "Convert errcheck output to SARIF (one warning per unchecked call)",
+	"gobench":       "Convert raw `go test -bench` output to fo:metrics (--baseline FILE: % change per metric)",
+	"gomod":         "Convert `go mod tidy -diff` or `go mod verify` output to SARIF",
+	"govet":         "Convert `go vet -json` output to SARIF (rule = analyzer)",
 	"jscpd":         "Convert jscpd JSON duplication report to SARIF",
+	"kubectl":       "Convert `kubectl apply` or `kubectl diff` output to fo:status, one row per resource",
 	"leaderboard":   "Convert '<count> <label>' tally to fo's tally format",
+	"slowtests":     "Rank the slowest tests in `go test -json` output as a fo:tally of seconds (--top N)",
 }
 
 // plainConvert is a wrapper whose only behavior is "parse no flags, then
@@ -45,8 +58,11 @@ var plainWrappers = map[string]plainConvert{
 	subJSCPD:        {"fo wrap jscpd", wrapjscpd.Convert},
 	"archlint-text": {"fo wrap archlint-text", wraparchlinttext.Convert},
 	"cover":         {"fo wrap cover", wrapcover.Convert},
-	"coverprofile":  {"fo wrap coverprofile", wrapcoverprofile.Convert},
-	"gobench":       {"fo wrap gobench", wrapgobench.Convert},
+	"docker":        {"fo wrap docker", wrapdocker.Convert},
+	"errcheck":      {"fo wrap errcheck", wraperrcheck.Convert},
+	"gomod":         {"fo wrap gomod", wrapgomod.Convert},
+	"govet":         {"fo wrap govet", wrapgovet.Convert},
+	"kubectl":       {"fo wrap kubectl", wrapkubectl.Convert},
 }
 
 func runWrap(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
@@ -71,6 +87,12 @@ func runWrap(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
 		return runWrapDiag(args[1:], stdin, stdout, stderr)
 	case subLeaderboard:
 		return runWrapLeaderboard(args[1:], stdin, stdout, stderr)
+	case subCoverprofile:
+		return runWrapCoverprofile(args[1:], stdin, stdout, stderr)
+	case subGobench:
+		return runWrapGobench(args[1:], stdin, stdout, stderr)
+	case subSlowtests:
+		return runWrapSlowtests(args[1:], stdin, stdout, stderr)
 	}
 
 	fmt.Fprintf(stderr, "fo wrap: unknown wrapper %q\n\nAvailable wrappers: %s\n",
@@ -136,6 +158,77 @@ func runWrapLeaderboard(args []string, stdin io.Reader, stdout, stderr io.Writer
 	return 0
 }
 
+func runWrapSlowtests(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("fo wrap slowtests", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	var opts wrapslowtests.Opts
+	fs.IntVar(&opts.Top, "top", wrapslowtests.DefaultTop, "Number of slowest tests to list")
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return 2
+	}
+	if err := wrapslowtests.Convert(stdin, stdout, opts); err != nil {
+		fmt.Fprintf(stderr, "fo wrap slowtests: %v\n", err)
+		return 2
+	}
+	return 0
+}
+
+func runWrapCoverprofile(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("fo wrap coverprofile", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	byPackage := fs.Bool("by-package", false, "Emit per-package statement coverage as fo:metrics, lowest first")
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return 2
+	}
+	convert := wrapcoverprofile.Convert
+	if *byPackage {
+		convert = wrapcoverprofile.ConvertByPackage
+	}
+	if err := convert(stdin, stdout); err != nil {
+		fmt.Fprintf(stderr, "fo wrap coverprofile: %v\n", err)
+		return 2
+	}
+	return 0
+}
+
+func runWrapGobench(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("fo wrap gobench", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	baseline := fs.String("baseline", "", "Baseline `file` of go test -bench output to compare stdin against (emits % change per metric)")
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return 2
+	}
+	var err error
+	if *baseline == "" {
+		err = wrapgobench.Convert(stdin, stdout)
+	} else {
+		err = compareBench(*baseline, stdin, stdout)
+	}
+	if err != nil {
+		fmt.Fprintf(stderr, "fo wrap gobench: %v\n", err)
+		return 2
+	}
+	return 0
+}
+
+func compareBench(path string, stdin io.Reader, stdout io.Writer) error {
+	f, err := os.Open(path) //nolint:gosec // user-supplied path is the contract
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return wrapgobench.Compare(f, stdin, stdout)
+}
+
 func runWrapList(args []string, stdout, stderr io.Writer) int {
 	fs := flag.NewFlagSet("fo wrap list", flag.ContinueOnError)
 	fs.SetOutput(stderr)
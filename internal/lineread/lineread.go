@@ -6,6 +6,7 @@ package lineread
 
 import (
 	"bufio"
+	"bytes"
 	"errors"
 )
 
@@ -72,3 +73,18 @@ func dropNL(b []byte) []byte {
 	}
 	return b
 }
+
+// LastSegment collapses carriage-return overwrites in line to what a
+// terminal would finally show: the text after the last '\r'. Progress
+// bars and spinners redraw with '\r', so a captured log line holds every
+// frame; only the last is meaningful. A trailing '\r' (CRLF line ending)
+// is dropped first so it doesn't empty the line.
+func LastSegment(line []byte) []byte {
+	if n := len(line); n > 0 && line[n-1] == '\r' {
+		line = line[:n-1]
+	}
+	if i := bytes.LastIndexByte(line, '\r'); i >= 0 {
+		return line[i+1:]
+	}
+	return line
+}
@@ -58,3 +58,17 @@ func TestRead_OversizeLineSkippedAndDrained(t *testing.T) {
 		t.Fatalf("third: line=%q ov=%v err=%v", line, ov, err)
 	}
 }
+
+func TestLastSegment(t *testing.T) {
+	for _, c := range []struct{ in, want string }{
+		{"plain", "plain"},
+		{"crlf\r", "crlf"},
+		{"10%\r50%\r100% done", "100% done"},
+		{"10%\r100% done\r", "100% done"},
+		{"", ""},
+	} {
+		if got := string(LastSegment([]byte(c.in))); got != c.want {
+			t.Errorf("LastSegment(%q) = %q, want %q", c.in, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+// Package ansi removes terminal escape sequences from rendered output, for
+// copies that land in files and logs instead of a terminal.
+package ansi
+
+import "io"
+
+const (
+	esc = 0x1b
+	bel = 0x07
+)
+
+// state is where a stripper sits in the escape grammar between bytes.
+type state uint8
+
+const (
+	stText   state = iota
+	stEsc          // saw ESC
+	stCSI          // inside ESC [ ... until a final byte
+	stOSC          // inside ESC ] ... until BEL or ESC \
+	stOSCEsc       // saw ESC inside an OSC; \ ends it
+)
+
+// stripper drops escape sequences byte by byte, so a sequence split
+// across two writes is still recognized. It knows:
+//
+//   - CSI: ESC [, parameter and intermediate bytes, then one final byte
+//     in @–~. Color (m), cursor movement, erase (ESC[2K), and private
+//     modes (ESC[?25l) all take this shape.
+//   - OSC: ESC ], a payload, then BEL or ST (ESC \). Window titles and
+//     hyperlinks use it.
+//   - Any other ESC plus one byte (ESC 7, ESC M, ...).
+type stripper struct {
+	st state
+}
+
+func (s *stripper) appendText(dst, p []byte) []byte {
+	for _, b := range p {
+		switch s.st {
+		case stText:
+			if b == esc {
+				s.st = stEsc
+				continue
+			}
+			dst = append(dst, b)
+		case stEsc:
+			switch b {
+			case '[':
+				s.st = stCSI
+			case ']':
+				s.st = stOSC
+			default:
+				s.st = stText
+			}
+		case stCSI:
+			if b >= '@' && b <= '~' {
+				s.st = stText
+			}
+		case stOSC:
+			switch b {
+			case bel:
+				s.st = stText
+			case esc:
+				s.st = stOSCEsc
+			}
+		case stOSCEsc:
+			if b == '\\' {
+				s.st = stText
+			} else {
+				s.st = stOSC
+			}
+		}
+	}
+	return dst
+}
+
+// Strip returns s with every escape sequence removed. A sequence left
+// unterminated at the end of s is dropped.
+func Strip(s string) string {
+	var st stripper
+	return string(st.appendText(make([]byte, 0, len(s)), []byte(s)))
+}
+
+// NewStripWriter returns a writer that strips escape sequences before
+// passing bytes to w. State carries across calls, so a sequence split
+// between two writes is removed whole. Write reports len(p) on success
+// so callers such as io.MultiWriter do not see a short write.
+func NewStripWriter(w io.Writer) io.Writer {
+	return &stripWriter{w: w}
+}
+
+type stripWriter struct {
+	w   io.Writer
+	st  stripper
+	buf []byte
+}
+
+func (s *stripWriter) Write(p []byte) (int, error) {
+	s.buf = s.st.appendText(s.buf[:0], p)
+	if len(s.buf) > 0 {
+		if _, err := s.w.Write(s.buf); err != nil {
+			return 0, err
+		}
+	}
+	return len(p), nil
+}
@@ -0,0 +1,60 @@
+package ansi_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/dkoosis/fo/pkg/ansi"
+)
+
+func TestStrip_AllCSISequences(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name, in, want string
+	}{
+		{"color", "\x1b[1;31mFAIL\x1b[0m", "FAIL"},
+		{"erase line", "\x1b[2Kdone", "done"},
+		{"cursor up", "a\x1b[3Ab", "ab"},
+		{"cursor hide/show", "\x1b[?25lspin\x1b[?25h", "spin"},
+		{"osc title bel", "\x1b]0;fo watch\x07ok", "ok"},
+		{"osc title st", "\x1b]2;fo \x1b[x]\x1b\\ok", "ok"},
+		{"osc hyperlink", "\x1b]8;;https://x.test\x1b\\link\x1b]8;;\x1b\\", "link"},
+		{"save cursor", "\x1b7a\x1b8", "a"},
+		{"plain", "no escapes [here]", "no escapes [here]"},
+	}
+	for _, c := range cases {
+		if got := ansi.Strip(c.in); got != c.want {
+			t.Errorf("%s: Strip(%q) = %q, want %q", c.name, c.in, got, c.want)
+		}
+	}
+}
+
+func TestNewStripWriter(t *testing.T) {
+	t.Parallel()
+
+	var b bytes.Buffer
+	in := []byte("\x1b[32mok\x1b[0m \x1b[2K\n")
+	n, err := ansi.NewStripWriter(&b).Write(in)
+	if err != nil || n != len(in) {
+		t.Fatalf("Write = %d, %v; want %d, nil", n, err, len(in))
+	}
+	if got := b.String(); got != "ok \n" {
+		t.Errorf("wrote %q, want %q", got, "ok \n")
+	}
+}
+
+func TestNewStripWriter_SequenceSplitAcrossWrites(t *testing.T) {
+	t.Parallel()
+
+	var b bytes.Buffer
+	w := ansi.NewStripWriter(&b)
+	for _, chunk := range []string{"a\x1b", "[2", "Kb\x1b]0;ti", "tle\x1b", "\\c"} {
+		if _, err := w.Write([]byte(chunk)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := b.String(); got != "abc" {
+		t.Errorf("wrote %q, want %q", got, "abc")
+	}
+}
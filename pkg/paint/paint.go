@@ -9,7 +9,8 @@ package paint
 import (
 	"math"
 	"strings"
-	"unicode/utf8"
+
+	"github.com/charmbracelet/lipgloss"
 )
 
 // Bar returns a `width`-cell horizontal bar filled in proportion to
@@ -61,6 +62,26 @@ func Sparkline(values []float64) string {
 	return b.String()
 }
 
+// SparkScale returns the annotation that gives a sparkline its scale —
+// "min 1.6s  max 2.4s  last 1.6s" — for rendering after the bars. A
+// sparkline alone shows shape but not magnitude. minMax and last select
+// the parts; format renders each value (e.g. appending a unit). Returns
+// "" for an empty slice or when neither part is selected.
+func SparkScale(values []float64, minMax, last bool, format func(float64) string) string {
+	if len(values) == 0 {
+		return ""
+	}
+	var parts []string
+	if minMax {
+		minV, maxV := sliceMinMax(values)
+		parts = append(parts, "min "+format(minV), "max "+format(maxV))
+	}
+	if last {
+		parts = append(parts, "last "+format(values[len(values)-1]))
+	}
+	return strings.Join(parts, "  ")
+}
+
 // sliceMinMax returns the minimum and maximum values of a non-empty slice.
 func sliceMinMax(values []float64) (minV, maxV float64) {
 	minV, maxV = values[0], values[0]
@@ -87,21 +108,29 @@ func sparkIndex(v, minV, span float64) int {
 	return max(1, min(8, int(math.Round((v-minV)/span*7))+1))
 }
 
-// padRight left-aligns s within a column of `width` runes, padding
+// Width is the number of terminal columns s occupies: ANSI escapes count
+// zero and East Asian wide runes (CJK, most emoji) count two. Every
+// padding helper here measures with it, so styled or wide cells still
+// line up.
+func Width(s string) int {
+	return lipgloss.Width(s)
+}
+
+// padRight left-aligns s within a column of `width` cells, padding
 // with ASCII spaces. If s is wider than width, returns s unchanged.
 // Internal helper for Columnize.
 func padRight(s string, width int) string {
-	w := utf8.RuneCountInString(s)
+	w := Width(s)
 	if w >= width {
 		return s
 	}
 	return s + strings.Repeat(" ", width-w)
 }
 
-// PadLeft right-aligns s within a column of `width` runes, padding with
+// PadLeft right-aligns s within a column of `width` cells, padding with
 // ASCII spaces. If s is wider than width, returns s unchanged.
 func PadLeft(s string, width int) string {
-	w := utf8.RuneCountInString(s)
+	w := Width(s)
 	if w >= width {
 		return s
 	}
@@ -133,7 +162,7 @@ func Columnize(rows [][]string, gap int) string {
 	return out.String()
 }
 
-// columnWidths returns the column count and per-column max rune widths for rows.
+// columnWidths returns the column count and per-column max cell widths for rows.
 func columnWidths(rows [][]string) (cols int, widths []int) {
 	for _, r := range rows {
 		if len(r) > cols {
@@ -143,7 +172,7 @@ func columnWidths(rows [][]string) (cols int, widths []int) {
 	widths = make([]int, cols)
 	for _, r := range rows {
 		for i, c := range r {
-			if w := utf8.RuneCountInString(c); w > widths[i] {
+			if w := Width(c); w > widths[i] {
 				widths[i] = w
 			}
 		}
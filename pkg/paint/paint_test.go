@@ -2,6 +2,7 @@ package paint_test
 
 import (
 	"math"
+	"strconv"
 	"strings"
 	"testing"
 
@@ -64,6 +65,28 @@ func TestSparkline(t *testing.T) {
 	}
 }
 
+func TestSparkScale(t *testing.T) {
+	t.Parallel()
+
+	secs := func(v float64) string { return strconv.FormatFloat(v, 'f', 1, 64) + "s" }
+	vals := []float64{2.4, 1.9, 1.6}
+	if got := paint.SparkScale(vals, true, true, secs); got != "min 1.6s  max 2.4s  last 1.6s" {
+		t.Errorf("min/max/last = %q", got)
+	}
+	if got := paint.SparkScale(vals, false, true, secs); got != "last 1.6s" {
+		t.Errorf("last only = %q", got)
+	}
+	if got := paint.SparkScale(vals, false, false, secs); got != "" {
+		t.Errorf("nothing selected = %q, want empty", got)
+	}
+	if got := paint.SparkScale(nil, true, true, secs); got != "" {
+		t.Errorf("empty input = %q, want empty", got)
+	}
+	if got := paint.SparkScale([]float64{3}, true, true, secs); got != "min 3.0s  max 3.0s  last 3.0s" {
+		t.Errorf("single value = %q", got)
+	}
+}
+
 func TestPad(t *testing.T) {
 	t.Parallel()
 
@@ -91,6 +114,29 @@ func TestColumnize_AlignsToWidestCell(t *testing.T) {
 	}
 }
 
+func TestColumnize_WideAndStyledCells(t *testing.T) {
+	t.Parallel()
+
+	// 日本 is two runes but four columns; the escape codes occupy none.
+	rows := [][]string{
+		{"日本", "\x1b[2mx\x1b[0m", "1"},
+		{"abc", "yy", "2"},
+	}
+	lines := strings.Split(paint.Columnize(rows, 2), "\n")
+	want := []string{
+		"日本  \x1b[2mx\x1b[0m   1",
+		"abc   yy  2",
+	}
+	for i := range want {
+		if lines[i] != want[i] {
+			t.Errorf("line %d = %q, want %q", i, lines[i], want[i])
+		}
+	}
+	if got := paint.Width("日本"); got != 4 {
+		t.Errorf("Width(日本) = %d, want 4", got)
+	}
+}
+
 func TestColumnize_RaggedRows(t *testing.T) {
 	t.Parallel()
 
@@ -65,6 +65,10 @@ type TestResult struct {
 	Fingerprint string        `json:"fingerprint,omitempty"`
 	Score       float64       `json:"score"`
 	ClusterID   string        `json:"cluster_id,omitempty"`
+	// Flaky marks a failing test that also passed within the same run
+	// (go test -count=N). The outcome stays fail — it did fail — but
+	// renderers flag it as intermittent rather than broken.
+	Flaky bool `json:"flaky,omitempty"`
 }
 
 // Cluster groups failing tests that share a root cause — same topmost
@@ -81,6 +85,11 @@ type Cluster struct {
 	Members       []string `json:"members"`
 }
 
+// SchemaVersion is the version of the --format json Report shape described
+// by report.schema.json. Bump it whenever a field is added, removed,
+// renamed, or changes meaning, and update the schema in the same change.
+const SchemaVersion = 1
+
 // Report is the canonical shape from parser to pickView to renderer.
 // One Report per analysis run. Substrate parsers produce it via ToReport;
 // the renderer consumes it via pickView.
@@ -104,6 +113,9 @@ type Report struct {
 	// during this run. Zero when no suppressions matched or no .fo/ignore
 	// file was loaded.
 	Suppressed int `json:"suppressed"`
+	// SchemaVersion is stamped by the JSON writer (always SchemaVersion)
+	// so consumers can detect a shape they were not built against.
+	SchemaVersion int `json:"schema_version"`
 }
 
 // DiffItem mirrors the shape of state.Item without importing pkg/state
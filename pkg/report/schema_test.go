@@ -96,3 +96,37 @@ func TestSchemaRoundtripSampleReport(t *testing.T) {
 		}
 	}
 }
+
+// TestReportJSONRoundTrip pins that every field survives encode/decode, so
+// a tag typo or a non-round-trippable type is caught before consumers of
+// --format json see it.
+func TestReportJSONRoundTrip(t *testing.T) {
+	t.Parallel()
+	want := Report{
+		Tool:        "go test",
+		GeneratedAt: time.Unix(1700000000, 0).UTC(),
+		DataHash:    "abc",
+		Findings: []Finding{{
+			ID: "F-1", RuleID: "x", File: "a.go", Line: 3, Col: 2,
+			Severity: SeverityWarning, Message: "m", Fingerprint: "f1", Score: 1.5,
+		}},
+		Tests: []TestResult{{
+			ID: "T-1", Package: "p", Test: "TestA", Outcome: OutcomeFail,
+			Duration: time.Second, Output: "boom", Fingerprint: "t1", Flaky: true,
+		}},
+		Notices:       []string{"n"},
+		Suppressed:    2,
+		SchemaVersion: SchemaVersion,
+	}
+	b, err := json.Marshal(want)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	var got Report
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("round trip mismatch\n got: %+v\nwant: %+v", got, want)
+	}
+}
@@ -203,7 +203,11 @@ type pkgState struct {
 	skipped     int
 	duration    time.Duration
 	coverage    float64
-	failedOrder []string // failed test names in run order
+	failedOrder []string // distinct failed test names in first-failure order
+	// failedNames/passedNames record every test that reported each outcome
+	// at least once; a name in both is flaky under -count=N.
+	failedNames map[string]bool
+	passedNames map[string]bool
 	buildError  string
 	buildOutput []string
 	panicked    bool
@@ -246,6 +250,8 @@ func (a *aggregator) getOrCreate(name string) *pkgState {
 		name:           name,
 		outputBuf:      make(map[string][]string),
 		outputBufBytes: make(map[string]int),
+		failedNames:    make(map[string]bool),
+		passedNames:    make(map[string]bool),
 	}
 	a.packages[name] = pkg
 	a.order = append(a.order, name)
@@ -305,8 +311,13 @@ func (a *aggregator) handleBuildEvent(e TestEvent) {
 func (*aggregator) handlePass(pkg *pkgState, e TestEvent) {
 	if e.Test != "" {
 		pkg.passed++
-		delete(pkg.outputBuf, e.Test)
-		delete(pkg.outputBufBytes, e.Test)
+		pkg.passedNames[e.Test] = true
+		// A pass after an earlier failure of the same test keeps the
+		// buffer: that failure's output is still owed to the report.
+		if !pkg.failedNames[e.Test] {
+			delete(pkg.outputBuf, e.Test)
+			delete(pkg.outputBufBytes, e.Test)
+		}
 	} else {
 		pkg.duration = time.Duration(e.Elapsed * float64(time.Second))
 	}
@@ -314,8 +325,13 @@ func (*aggregator) handlePass(pkg *pkgState, e TestEvent) {
 
 func (*aggregator) handleFail(pkg *pkgState, e TestEvent) {
 	if e.Test != "" {
-		pkg.failed++
-		pkg.failedOrder = append(pkg.failedOrder, e.Test)
+		// Under -count=N a test can fail on several runs; it is still one
+		// failing test with one row, its output buffer holding every run.
+		if !pkg.failedNames[e.Test] {
+			pkg.failed++
+			pkg.failedOrder = append(pkg.failedOrder, e.Test)
+			pkg.failedNames[e.Test] = true
+		}
 		return
 	}
 	pkg.duration = time.Duration(e.Elapsed * float64(time.Second))
@@ -406,6 +422,7 @@ func (a *aggregator) results() []TestPackageResult {
 			r.FailedTests = append(r.FailedTests, FailedTest{
 				Name:   testName,
 				Output: outCopy,
+				Flaky:  pkg.passedNames[testName],
 			})
 		}
 
@@ -227,6 +227,65 @@ func TestProcessEvent_FreesOutputOnPassAndSkip(t *testing.T) {
 	}
 }
 
+func TestParseStream_FlakyUnderCount(t *testing.T) {
+	t.Parallel()
+
+	// go test -count=2: TestFlaky fails then passes, TestBroken fails twice.
+	input := `{"Action":"run","Package":"p","Test":"TestFlaky"}
+{"Action":"output","Package":"p","Test":"TestFlaky","Output":"flake_test.go:9: timeout\n"}
+{"Action":"fail","Package":"p","Test":"TestFlaky","Elapsed":0.1}
+{"Action":"run","Package":"p","Test":"TestBroken"}
+{"Action":"fail","Package":"p","Test":"TestBroken","Elapsed":0}
+{"Action":"run","Package":"p","Test":"TestFlaky"}
+{"Action":"pass","Package":"p","Test":"TestFlaky","Elapsed":0.1}
+{"Action":"run","Package":"p","Test":"TestBroken"}
+{"Action":"fail","Package":"p","Test":"TestBroken","Elapsed":0}
+{"Action":"fail","Package":"p","Elapsed":0.3}
+`
+	results, _, err := ParseStream(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("ParseStream() error = %v", err)
+	}
+	flaky := map[string]bool{}
+	for _, ft := range results[0].FailedTests {
+		flaky[ft.Name] = ft.Flaky
+		if ft.Name == "TestFlaky" && !strings.Contains(strings.Join(ft.Output, "\n"), "timeout") {
+			t.Errorf("TestFlaky output lost after its passing rerun: %q", ft.Output)
+		}
+	}
+	if !flaky["TestFlaky"] {
+		t.Error("TestFlaky should be flagged flaky (failed and passed)")
+	}
+	if flaky["TestBroken"] {
+		t.Error("TestBroken failed every run and should not be flaky")
+	}
+}
+
+func TestParseStream_FlakyCount3FailFailPassIsOneRow(t *testing.T) {
+	t.Parallel()
+
+	// go test -count=3: TestFlaky fails twice, then passes.
+	input := `{"Action":"run","Package":"p","Test":"TestFlaky"}
+{"Action":"fail","Package":"p","Test":"TestFlaky","Elapsed":0.1}
+{"Action":"run","Package":"p","Test":"TestFlaky"}
+{"Action":"fail","Package":"p","Test":"TestFlaky","Elapsed":0.1}
+{"Action":"run","Package":"p","Test":"TestFlaky"}
+{"Action":"pass","Package":"p","Test":"TestFlaky","Elapsed":0.1}
+{"Action":"fail","Package":"p","Elapsed":0.3}
+`
+	results, _, err := ParseStream(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("ParseStream() error = %v", err)
+	}
+	got := results[0]
+	if got.Failed != 1 || len(got.FailedTests) != 1 {
+		t.Fatalf("Failed = %d, FailedTests = %d; want one row for the one test", got.Failed, len(got.FailedTests))
+	}
+	if !got.FailedTests[0].Flaky {
+		t.Error("TestFlaky should be flagged flaky")
+	}
+}
+
 // TestStreamMode_LargePerTestOutputBounded verifies that a single failing
 // test emitting many MB of output does not balloon outputBuf — the parser
 // caps per-test buffering and emits a truncation sentinel.
@@ -65,6 +65,7 @@ func ToReport(results []TestPackageResult) *report.Report {
 					Package:     pkg.Name,
 					Test:        ft.Name,
 					Outcome:     report.OutcomeFail,
+					Flaky:       ft.Flaky,
 					Output:      out,
 					FixCommand:  testFixCommand(pkg.Name, ft.Name),
 					Fingerprint: fingerprint.Fingerprint(ft.Name, pkg.Name, out),
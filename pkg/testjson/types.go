@@ -57,10 +57,13 @@ type TestPackageResult struct {
 	PanicOutput []string
 }
 
-// FailedTest captures a test failure with its output.
+// FailedTest captures a test failure with its output. Flaky is set when
+// the same test also passed within the run (go test -count=N), so the
+// failure is intermittent rather than deterministic.
 type FailedTest struct {
 	Name   string
 	Output []string
+	Flaky  bool
 }
 
 // TotalTests returns the total number of tests in this package.
@@ -1,13 +1,17 @@
 // Package theme provides the v2 Tufte-Swiss theme system: structure
 // (bold, dim, alignment) lives in the mono preset; color layers on top.
 //
-// Two presets, no interface. Mono is the base — Color calls Mono first
-// and overlays chroma on the severity and outcome styles. NO_COLOR forces
-// Mono regardless of TTY (this is checked by Default).
+// Presets, no interface. Mono is the base — Color calls Mono first and
+// overlays chroma on the severity and outcome styles; Light and
+// HighContrast build on Color for light backgrounds and low-vision
+// readers; MonoUnicode keeps Color's glyphs with no color at all. Under
+// NO_COLOR, Default picks Mono regardless of TTY, but a colorless preset
+// the user names explicitly (mono-unicode) is kept.
 package theme
 
 import (
 	"os"
+	"sort"
 
 	"github.com/charmbracelet/lipgloss"
 )
@@ -35,11 +39,16 @@ type Theme struct {
 	Heading lipgloss.Style
 
 	Icons Icons
+
+	// colorless is set by the presets that apply no foreground color and
+	// inherited by those built on them; see Colorless.
+	colorless bool
 }
 
 // Icons are the Tufte-Swiss glyph set: minimal, no box-drawing.
 // Bar / BarEmpty are the segments used by the paint package's bar
-// primitive; Up / Down / Same drive the Delta view.
+// primitive; Up / Down / Same drive the Delta view. Spinner holds the
+// frames of an in-progress animation, one rune each.
 type Icons struct {
 	Pass       string
 	Fail       string
@@ -53,6 +62,7 @@ type Icons struct {
 	Up         string
 	Down       string
 	Same       string
+	Spinner    string
 }
 
 // Mono is the structure-only preset. Bold and dim do all the hierarchy
@@ -79,6 +89,8 @@ func Mono() Theme {
 		Muted:   dim,
 		Heading: bold,
 
+		colorless: true,
+
 		Icons: Icons{
 			Pass:       "+",
 			Fail:       "x",
@@ -92,6 +104,7 @@ func Mono() Theme {
 			Up:         "^",
 			Down:       "v",
 			Same:       "=",
+			Spinner:    `|/-\`,
 		},
 	}
 }
@@ -102,6 +115,7 @@ func Mono() Theme {
 func Color() Theme {
 	t := Mono()
 	t.Name = "color"
+	t.colorless = false
 
 	red := lipgloss.Color("196")
 	orange := lipgloss.Color("214")
@@ -133,7 +147,114 @@ func Color() Theme {
 		Up:         "▲",
 		Down:       "▼",
 		Same:       "·",
+		Spinner:    "⠋⠙⠹⠸⠼⠴⠦⠧⠇⠏",
+	}
+	return t
+}
+
+// MonoUnicode is Mono's styles with Color's glyphs: no color at all, but
+// the Unicode check marks, bars, and arrows stay. For terminals that
+// render Unicode fine but where color is unwanted — it satisfies NO_COLOR,
+// which disables color, not glyphs — so an explicit --theme mono-unicode
+// is honored even when NO_COLOR is set.
+func MonoUnicode() Theme {
+	t := Mono()
+	t.Name = "mono-unicode"
+	t.Icons = Color().Icons
+	return t
+}
+
+// spinnerStyles is the catalog of named spinner frame sets a caller can
+// pick over the theme's own Icons.Spinner.
+var spinnerStyles = map[string]string{
+	"line":    `|/-\`,
+	"braille": "⠋⠙⠹⠸⠼⠴⠦⠧⠇⠏",
+	"dots":    "⣾⣽⣻⢿⡿⣟⣯⣷",
+	"pulse":   "·✢✳✶✻✽✻✶✳✢",
+}
+
+// SpinnerStyle returns the frames of a named spinner style and whether
+// the name is known.
+func SpinnerStyle(name string) (string, bool) {
+	frames, ok := spinnerStyles[name]
+	return frames, ok
+}
+
+// SpinnerStyleNames lists the known spinner styles, sorted.
+func SpinnerStyleNames() []string {
+	names := make([]string, 0, len(spinnerStyles))
+	for n := range spinnerStyles {
+		names = append(names, n)
 	}
+	sort.Strings(names)
+	return names
+}
+
+// Colorless reports whether t applies no foreground color, so callers
+// that add color of their own (e.g. per-actor hues in scenes) can hold
+// back under it. It is a property of the preset, not of its Name.
+func (t Theme) Colorless() bool {
+	return t.colorless
+}
+
+// Light is Color re-tuned for light terminal backgrounds. Color's
+// yellow (220) and gray (242) wash out on white, so every hue moves to a
+// darker shade of the same family. Faint text fades the same way, so
+// Muted is an explicit dark gray instead of dim; glyphs are unchanged.
+func Light() Theme {
+	t := Color()
+	t.Name = "light"
+
+	red := lipgloss.Color("160")
+	orange := lipgloss.Color("166")
+	yellow := lipgloss.Color("136")
+	green := lipgloss.Color("28")
+	gray := lipgloss.Color("238")
+	magenta := lipgloss.Color("127")
+
+	t.Error = t.Error.Foreground(red)
+	t.Warning = t.Warning.Foreground(orange)
+	t.Note = t.Note.Foreground(gray)
+
+	t.Pass = t.Pass.Foreground(green)
+	t.Fail = t.Fail.Foreground(red)
+	t.Skip = t.Skip.Foreground(yellow)
+	t.Panic = t.Panic.Foreground(magenta)
+	t.BuildError = t.BuildError.Foreground(red)
+
+	t.Muted = lipgloss.NewStyle().Foreground(lipgloss.Color("240"))
+	return t
+}
+
+// HighContrast is Color tuned for low vision and washed-out displays:
+// mid-luminance 256-color shades that hold about 4:1 contrast against both
+// black and white (the bright ANSI colors wash out on a light background),
+// the terminal's own foreground for notes, bold on every severity and
+// outcome, and no faint text — dim is the first thing to vanish at low
+// contrast, so Muted is plain instead and Heading adds an underline to
+// keep the hierarchy.
+func HighContrast() Theme {
+	t := Color()
+	t.Name = "high-contrast"
+
+	red := lipgloss.Color("196")
+	green := lipgloss.Color("28")
+	amber := lipgloss.Color("130")
+	magenta := lipgloss.Color("163")
+	bold := lipgloss.NewStyle().Bold(true)
+
+	t.Error = bold.Foreground(red)
+	t.Warning = bold.Foreground(amber)
+	t.Note = bold
+
+	t.Pass = bold.Foreground(green)
+	t.Fail = bold.Foreground(red)
+	t.Skip = bold.Foreground(amber)
+	t.Panic = bold.Foreground(magenta)
+	t.BuildError = bold.Foreground(red)
+
+	t.Muted = lipgloss.NewStyle()
+	t.Heading = bold.Underline(true)
 	return t
 }
 
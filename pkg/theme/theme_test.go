@@ -3,6 +3,8 @@ package theme_test
 import (
 	"testing"
 
+	"github.com/charmbracelet/lipgloss"
+
 	"github.com/dkoosis/fo/pkg/theme"
 )
 
@@ -22,6 +24,7 @@ func TestMono_NoColors(t *testing.T) {
 		{"Fail icon", m.Icons.Fail},
 		{"Bar", m.Icons.Bar},
 		{"Up", m.Icons.Up},
+		{"Spinner", m.Icons.Spinner},
 	} {
 		for _, r := range c.got {
 			if r > 0x7F {
@@ -31,6 +34,20 @@ func TestMono_NoColors(t *testing.T) {
 	}
 }
 
+func TestSpinnerStyles_Catalog(t *testing.T) {
+	t.Parallel()
+
+	for _, name := range theme.SpinnerStyleNames() {
+		frames, ok := theme.SpinnerStyle(name)
+		if !ok || len([]rune(frames)) < 2 {
+			t.Errorf("style %q: frames %q, want at least two", name, frames)
+		}
+	}
+	if _, ok := theme.SpinnerStyle("nope"); ok {
+		t.Error("unknown style should not resolve")
+	}
+}
+
 func TestColor_OverlaysMono(t *testing.T) {
 	t.Parallel()
 
@@ -44,6 +61,83 @@ func TestColor_OverlaysMono(t *testing.T) {
 	}
 }
 
+func TestMonoUnicode_NoColorUnicodeGlyphs(t *testing.T) {
+	t.Parallel()
+
+	mu := theme.MonoUnicode()
+	if mu.Icons != theme.Color().Icons {
+		t.Error("MonoUnicode should carry Color's glyphs")
+	}
+	for name, s := range map[string]lipgloss.Style{
+		"Error": mu.Error, "Warning": mu.Warning, "Note": mu.Note, "Pass": mu.Pass, "Fail": mu.Fail,
+	} {
+		if _, isNoColor := s.GetForeground().(lipgloss.NoColor); !isNoColor {
+			t.Errorf("%s has a foreground color: %v", name, s.GetForeground())
+		}
+	}
+	if !mu.Colorless() || !theme.Mono().Colorless() || theme.Color().Colorless() {
+		t.Error("Colorless should hold for mono presets only")
+	}
+	renamed := theme.Mono()
+	renamed.Name = "plain"
+	if !renamed.Colorless() {
+		t.Error("Colorless should not depend on the preset's display name")
+	}
+}
+
+func TestLight_DarkerThanColor(t *testing.T) {
+	t.Parallel()
+
+	l, c := theme.Light(), theme.Color()
+	if l.Name != "light" {
+		t.Errorf("Name = %q, want light", l.Name)
+	}
+	// The washed-out shades must not survive into the light preset.
+	for name, pair := range map[string][2]lipgloss.Style{
+		"Note": {l.Note, c.Note}, "Skip": {l.Skip, c.Skip}, "Warning": {l.Warning, c.Warning},
+	} {
+		if pair[0].GetForeground() == pair[1].GetForeground() {
+			t.Errorf("%s foreground unchanged from Color (%v)", name, pair[1].GetForeground())
+		}
+	}
+	if l.Muted.GetFaint() || l.Muted.GetForeground() == c.Muted.GetForeground() {
+		t.Error("Light Muted should be an explicit gray, not faint")
+	}
+	if l.Icons != c.Icons {
+		t.Error("Light should keep Color's glyphs")
+	}
+}
+
+func TestHighContrast_BoldAndNeverFaint(t *testing.T) {
+	t.Parallel()
+
+	hc := theme.HighContrast()
+	if hc.Name != "high-contrast" {
+		t.Errorf("Name = %q, want high-contrast", hc.Name)
+	}
+	for name, s := range map[string]lipgloss.Style{
+		"Error": hc.Error, "Warning": hc.Warning, "Note": hc.Note,
+		"Pass": hc.Pass, "Fail": hc.Fail, "Skip": hc.Skip,
+	} {
+		if !s.GetBold() {
+			t.Errorf("%s should be bold", name)
+		}
+		if s.GetFaint() {
+			t.Errorf("%s should not be faint", name)
+		}
+	}
+	if hc.Muted.GetFaint() {
+		t.Error("Muted should not be faint in high contrast")
+	}
+	// Bright white and bright yellow vanish on a light background.
+	for name, s := range map[string]lipgloss.Style{"Note": hc.Note, "Warning": hc.Warning, "Skip": hc.Skip} {
+		switch s.GetForeground() {
+		case lipgloss.Color("11"), lipgloss.Color("15"):
+			t.Errorf("%s uses a bright color unreadable on white: %v", name, s.GetForeground())
+		}
+	}
+}
+
 func TestDefault_NoColorEnvForcesMono(t *testing.T) {
 	t.Setenv("NO_COLOR", "1")
 	got := theme.Default(theme.OutputTTY)
@@ -71,7 +165,7 @@ func TestDefault_TTYWithColor(t *testing.T) {
 func TestDefault_AllSeverityStylesPopulated(t *testing.T) {
 	t.Parallel()
 
-	for _, th := range []theme.Theme{theme.Mono(), theme.Color()} {
+	for _, th := range []theme.Theme{theme.Mono(), theme.Color(), theme.Light(), theme.HighContrast()} {
 		t.Run(th.Name, func(t *testing.T) {
 			t.Parallel()
 			if th.Error.Render("x") == "" {
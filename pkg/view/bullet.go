@@ -17,9 +17,13 @@ type styler func(string) string
 func wrap(s lipgloss.Style) styler { return func(x string) string { return s.Render(x) } }
 
 // glyphFor returns the styled glyph for a row, picking severity over
-// outcome when both are set. Falls back to the bullet glyph + identity
-// style when neither is set.
+// outcome when both are set. A flaky test takes the warning glyph so it
+// reads as intermittent next to deterministic failures. Falls back to the
+// bullet glyph + identity style when neither is set.
 func glyphFor(item BulletItem, t theme.Theme) (string, styler) {
+	if item.Flaky {
+		return t.Icons.Warn, wrap(t.Warning)
+	}
 	if item.Severity != "" {
 		switch item.Severity {
 		case report.SeverityError:
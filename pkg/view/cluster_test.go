@@ -2,18 +2,14 @@
 package view
 
 import (
-	"regexp"
 	"strings"
 	"testing"
 
+	"github.com/dkoosis/fo/pkg/ansi"
 	"github.com/dkoosis/fo/pkg/report"
 	"github.com/dkoosis/fo/pkg/theme"
 )
 
-var clusterAnsiRE = regexp.MustCompile(`\x1b\[[0-9;]*[a-zA-Z]`)
-
-func stripANSI(s string) string { return clusterAnsiRE.ReplaceAllString(s, "") }
-
 func TestExpandSet_All(t *testing.T) {
 	e := newExpandSet([]string{"all"})
 	if !e.wants("cluster-anything") {
@@ -336,7 +332,7 @@ func TestRender_ClusterThemeParity(t *testing.T) {
 	// (line count, whitespace, glyphs, content) is identical.
 	// Structural parity: same line count (theme differs in glyph choice
 	// — mono "x" vs color "✗" — not in layout).
-	if got, want := len(strings.Split(stripANSI(color), "\n")), len(strings.Split(mono, "\n")); got != want {
+	if got, want := len(strings.Split(ansi.Strip(color), "\n")), len(strings.Split(mono, "\n")); got != want {
 		t.Errorf("line count: mono=%d color(stripped)=%d", want, got)
 	}
 	// Both outputs must contain the cluster header signature and expand hint.
@@ -344,7 +340,7 @@ func TestRender_ClusterThemeParity(t *testing.T) {
 		if !strings.Contains(mono, sub) {
 			t.Errorf("mono missing %q", sub)
 		}
-		if !strings.Contains(stripANSI(color), sub) {
+		if !strings.Contains(ansi.Strip(color), sub) {
 			t.Errorf("color(stripped) missing %q", sub)
 		}
 	}
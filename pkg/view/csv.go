@@ -0,0 +1,98 @@
+package view
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/dkoosis/fo/pkg/report"
+)
+
+// RenderCSV writes a Report as one CSV table for spreadsheets: a header
+// row, then one row per finding and one per test result. Findings and
+// tests share columns — kind says which, and level holds the severity or
+// the outcome, with flaky "true" for a test that also passed in the run —
+// so the file sorts and filters as a single sheet. Like
+// Markdown there is no PickView step; every item is emitted. Quoting of
+// commas, quotes, and multi-line test output is encoding/csv's; tool
+// text is passed through csvText so a spreadsheet never runs it.
+func RenderCSV(w io.Writer, r report.Report) error {
+	cw := csv.NewWriter(w)
+	_ = cw.Write([]string{"kind", "id", "level", "rule", "file", "line", "col", "package", "test", "flaky", "message", "fix_command"})
+	for i := range r.Findings {
+		f := &r.Findings[i]
+		_ = cw.Write([]string{
+			"finding", f.ID, string(f.Severity), csvText(f.RuleID), csvText(f.File),
+			csvInt(f.Line), csvInt(f.Col), "", "", "", csvText(f.Message), csvText(f.FixCommand),
+		})
+	}
+	for i := range r.Tests {
+		t := &r.Tests[i]
+		flaky := ""
+		if t.Flaky {
+			flaky = "true"
+		}
+		_ = cw.Write([]string{
+			"test", t.ID, string(t.Outcome), "", "", "", "", csvText(t.Package), csvText(t.Test), flaky,
+			csvText(t.Output), csvText(t.FixCommand),
+		})
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// RenderLeaderboardCSV writes tally rows as label,value.
+func RenderLeaderboardCSV(w io.Writer, v Leaderboard) error {
+	cw := csv.NewWriter(w)
+	_ = cw.Write([]string{"label", "value"})
+	for _, r := range v.Rows {
+		_ = cw.Write([]string{csvText(r.Label), strconv.FormatFloat(r.Value, 'f', -1, 64)})
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// RenderStatusCSV writes status rows as state,label,value,note.
+func RenderStatusCSV(w io.Writer, rows []StatusRow) error {
+	cw := csv.NewWriter(w)
+	_ = cw.Write([]string{"state", "label", "value", "note"})
+	for _, r := range rows {
+		_ = cw.Write([]string{r.State, csvText(r.Label), csvText(r.Value), csvText(r.Note)})
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// RenderMetricsCSV writes metric rows with their delta against the prior
+// run; new is "true" when there was no prior sample to compare.
+func RenderMetricsCSV(w io.Writer, rows []MetricRow) error {
+	cw := csv.NewWriter(w)
+	_ = cw.Write([]string{"key", "value", "unit", "delta", "new"})
+	for _, r := range rows {
+		_ = cw.Write([]string{
+			csvText(r.Key), strconv.FormatFloat(r.Value, 'f', -1, 64), csvText(r.Unit),
+			strconv.FormatFloat(r.Delta, 'f', -1, 64), strconv.FormatBool(r.New),
+		})
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// csvText neutralizes a cell a spreadsheet would evaluate as a formula —
+// one starting with =, +, -, @, tab, or CR — by prefixing a single quote.
+// Numbers fo formats itself are written as-is so they stay numeric.
+func csvText(s string) string {
+	if s != "" && strings.ContainsRune("=+-@\t\r", rune(s[0])) {
+		return "'" + s
+	}
+	return s
+}
+
+// csvInt renders a location number, leaving 0 (unknown) blank.
+func csvInt(n int) string {
+	if n == 0 {
+		return ""
+	}
+	return strconv.Itoa(n)
+}
@@ -0,0 +1,89 @@
+package view_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+
+	"github.com/dkoosis/fo/pkg/report"
+	"github.com/dkoosis/fo/pkg/view"
+)
+
+func TestRenderCSV_RoundTripsQuotedFields(t *testing.T) {
+	t.Parallel()
+
+	r := report.Report{
+		Findings: []report.Finding{{
+			RuleID: "SA1000", Severity: report.SeverityError, File: "a.go", Line: 3,
+			Message: `bad "regexp", see docs`,
+		}},
+		Tests: []report.TestResult{{
+			Package: "p", Test: "TestX", Outcome: report.OutcomeFail, Flaky: true, Output: "line 1\nline 2",
+		}},
+	}
+	var buf bytes.Buffer
+	if err := view.RenderCSV(&buf, r); err != nil {
+		t.Fatal(err)
+	}
+	recs, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("output is not valid CSV: %v\n%s", err, buf.String())
+	}
+	if len(recs) != 3 {
+		t.Fatalf("records = %d, want header + 2", len(recs))
+	}
+	if got := recs[1]; got[0] != "finding" || got[2] != "error" || got[5] != "3" || got[6] != "" || got[10] != `bad "regexp", see docs` {
+		t.Errorf("finding row = %q", got)
+	}
+	if got := recs[2]; got[0] != "test" || got[2] != "fail" || got[8] != "TestX" || got[9] != "true" || got[10] != "line 1\nline 2" {
+		t.Errorf("test row = %q", got)
+	}
+}
+
+func TestRenderCSV_NeutralizesFormulaCells(t *testing.T) {
+	t.Parallel()
+
+	r := report.Report{Findings: []report.Finding{{
+		RuleID: "@SUM(A1)", Severity: report.SeverityWarning, File: "a.go",
+		Message: `=HYPERLINK("http://x.test","click")`,
+	}}, Tests: []report.TestResult{{
+		Package: "p", Test: "TestX", Outcome: report.OutcomeFail, Output: "-2+3\nmore",
+	}}}
+	var buf bytes.Buffer
+	if err := view.RenderCSV(&buf, r); err != nil {
+		t.Fatal(err)
+	}
+	recs, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := recs[1][3]; got != "'@SUM(A1)" {
+		t.Errorf("rule = %q, want quote-prefixed", got)
+	}
+	if got := recs[1][10]; got != `'=HYPERLINK("http://x.test","click")` {
+		t.Errorf("message = %q, want quote-prefixed", got)
+	}
+	if got := recs[2][10]; got != "'-2+3\nmore" {
+		t.Errorf("output = %q, want quote-prefixed", got)
+	}
+
+	buf.Reset()
+	if err := view.RenderMetricsCSV(&buf, []view.MetricRow{{Key: "+k", Value: -1.5, Delta: -2}}); err != nil {
+		t.Fatal(err)
+	}
+	if want := "key,value,unit,delta,new\n'+k,-1.5,,-2,false\n"; buf.String() != want {
+		t.Errorf("metrics = %q, want %q (numbers stay numeric)", buf.String(), want)
+	}
+}
+
+func TestRenderStatusCSV(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	if err := view.RenderStatusCSV(&buf, []view.StatusRow{{State: "fail", Label: "db", Value: "down", Note: "a, b"}}); err != nil {
+		t.Fatal(err)
+	}
+	if want := "state,label,value,note\nfail,db,down,\"a, b\"\n"; buf.String() != want {
+		t.Errorf("got %q, want %q", buf.String(), want)
+	}
+}
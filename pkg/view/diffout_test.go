@@ -4,6 +4,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/dkoosis/fo/pkg/ansi"
 	"github.com/dkoosis/fo/pkg/theme"
 )
 
@@ -59,8 +60,8 @@ func TestRenderDiffOutput_PreservesLineCountAndContent(t *testing.T) {
 		if gotN, wantN := strings.Count(got, "\n"), strings.Count(in, "\n"); gotN != wantN {
 			t.Errorf("line count changed: got %d want %d", gotN, wantN)
 		}
-		if stripANSI(got) != in {
-			t.Errorf("visible text changed after styling:\n--- visible\n%s\n--- want\n%s", stripANSI(got), in)
+		if ansi.Strip(got) != in {
+			t.Errorf("visible text changed after styling:\n--- visible\n%s\n--- want\n%s", ansi.Strip(got), in)
 		}
 	}
 }
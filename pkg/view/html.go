@@ -0,0 +1,302 @@
+package view
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/dkoosis/fo/pkg/paint"
+	"github.com/dkoosis/fo/pkg/report"
+	"github.com/dkoosis/fo/pkg/theme"
+)
+
+// RenderHTML writes a Report as a self-contained HTML fragment for
+// archiving CI summaries: one <div> holding a heading, a findings table,
+// and a table of non-passing tests with output in <details>. It carries
+// the same data as RenderMarkdown; styling is inline CSS derived from the
+// theme's lipgloss styles (foreground color, bold, faint), so the fragment
+// embeds in a larger page without a stylesheet and matches the terminal.
+func RenderHTML(w io.Writer, r report.Report, t theme.Theme) error {
+	var b strings.Builder
+	writeHTMLOpen(&b, "fo-report")
+	writeHTMLHeading(&b, r, t)
+	if r.Diff != nil && r.Diff.Headline != "" {
+		fmt.Fprintf(&b, "<p%s>%s</p>\n", styleAttr(t.Muted), html.EscapeString(r.Diff.Headline))
+	}
+	if len(r.Findings) > 0 {
+		writeHTMLFindings(&b, r.Findings, t)
+	}
+	if failCount(r.Tests) > 0 {
+		writeHTMLTests(&b, r.Tests, t)
+	}
+	for _, n := range r.Notices {
+		fmt.Fprintf(&b, "<p%s>%s %s</p>\n", styleAttr(t.Warning), t.Icons.Warn, html.EscapeString(n))
+	}
+	b.WriteString("</div>\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// htmlBarWidth is the tally bar length in cells; a browser has no
+// terminal width to fit, so it is fixed.
+const htmlBarWidth = 20
+
+// RenderLeaderboardHTML writes tally rows as an HTML fragment: a table of
+// label, bar, and value, the bar split into filled and empty <span>
+// segments styled from the theme.
+func RenderLeaderboardHTML(w io.Writer, tool string, v Leaderboard, t theme.Theme) error {
+	var b strings.Builder
+	writeHTMLOpen(&b, "fo-tally")
+	writeHTMLToolHeading(&b, tool, t)
+	b.WriteString("<table>\n")
+	for _, r := range v.Rows {
+		bar := paint.Bar(r.Value, v.Total, htmlBarWidth, t.Icons.Bar, t.Icons.BarEmpty)
+		filled := strings.TrimRight(bar, t.Icons.BarEmpty)
+		fmt.Fprintf(&b, "<tr><td>%s</td><td><span%s>%s</span><span%s>%s</span></td><td%s>%s</td></tr>\n",
+			html.EscapeString(r.Label),
+			styleAttr(t.Bold), filled, styleAttr(t.Muted), bar[len(filled):],
+			styleAttr(t.Bold), strconv.FormatFloat(r.Value, 'f', -1, 64))
+	}
+	b.WriteString("</table>\n</div>\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// RenderStatusHTML writes status rows as an HTML fragment: a heading with
+// the non-zero state counts and a table with one styled icon per state.
+func RenderStatusHTML(w io.Writer, tool string, rows []StatusRow, t theme.Theme) error {
+	if tool == "" {
+		tool = "fo"
+	}
+	counts := map[string]int{}
+	for _, r := range rows {
+		counts[r.State]++
+	}
+	var parts []string
+	for _, st := range []string{stateOK, stateFail, stateWarn, stateSkip} {
+		if counts[st] > 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", counts[st], st))
+		}
+	}
+	style, icon := htmlState(stateOK, t)
+	switch {
+	case counts[stateFail] > 0:
+		style, icon = htmlState(stateFail, t)
+	case counts[stateWarn] > 0:
+		style, icon = htmlState(stateWarn, t)
+	}
+	var b strings.Builder
+	writeHTMLOpen(&b, "fo-status")
+	fmt.Fprintf(&b, "<h3%s>%s %s — %s</h3>\n",
+		styleAttr(style), icon, html.EscapeString(tool), strings.Join(parts, ", "))
+	b.WriteString("<table>\n<tr><th></th><th>Label</th><th>Value</th><th>Note</th></tr>\n")
+	for _, r := range rows {
+		style, icon := htmlState(r.State, t)
+		fmt.Fprintf(&b, "<tr><td%s>%s</td><td>%s</td><td>%s</td><td%s>%s</td></tr>\n",
+			styleAttr(style), icon, html.EscapeString(r.Label), html.EscapeString(r.Value),
+			styleAttr(t.Muted), html.EscapeString(r.Note))
+	}
+	b.WriteString("</table>\n</div>\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// RenderMetricsHTML writes metric rows as an HTML fragment: a table of
+// key, value with unit, and the change against the prior run.
+func RenderMetricsHTML(w io.Writer, tool string, rows []MetricRow, t theme.Theme) error {
+	var b strings.Builder
+	writeHTMLOpen(&b, "fo-metrics")
+	writeHTMLToolHeading(&b, tool, t)
+	b.WriteString("<table>\n<tr><th>Metric</th><th>Value</th><th>Change</th></tr>\n")
+	for _, r := range rows {
+		fmt.Fprintf(&b, "<tr><td><code>%s</code></td><td%s>%s</td><td%s>%s</td></tr>\n",
+			html.EscapeString(r.Key),
+			styleAttr(t.Bold), html.EscapeString(strconv.FormatFloat(r.Value, 'f', -1, 64)+formatUnit(r.Unit)),
+			styleAttr(t.Muted), strings.TrimSpace(formatDelta(r)))
+	}
+	b.WriteString("</table>\n</div>\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// writeHTMLOpen starts a fragment's outer <div>; the monospace font keeps
+// glyph bars and icons aligned as they are in the terminal.
+func writeHTMLOpen(b *strings.Builder, class string) {
+	fmt.Fprintf(b, `<div class="%s" style="font-family:ui-monospace,monospace">`+"\n", class)
+}
+
+func writeHTMLToolHeading(b *strings.Builder, tool string, t theme.Theme) {
+	if tool != "" {
+		fmt.Fprintf(b, "<h3%s>%s</h3>\n", styleAttr(t.Heading), html.EscapeString(tool))
+	}
+}
+
+func htmlState(state string, t theme.Theme) (lipgloss.Style, string) {
+	switch state {
+	case stateOK:
+		return t.Pass, t.Icons.Pass
+	case stateFail:
+		return t.Fail, t.Icons.Fail
+	case stateWarn:
+		return t.Warning, t.Icons.Warn
+	default:
+		return t.Skip, t.Icons.Note
+	}
+}
+
+func writeHTMLHeading(b *strings.Builder, r report.Report, t theme.Theme) {
+	tool := r.Tool
+	if tool == "" {
+		tool = "fo"
+	}
+	if isClean(r) {
+		fmt.Fprintf(b, "<h3%s>%s %s — no findings</h3>\n",
+			styleAttr(t.Pass), t.Icons.Pass, html.EscapeString(tool))
+		return
+	}
+	e, wn, n := severityCounts(r.Findings)
+	fails := failCount(r.Tests)
+	var parts []string
+	for _, c := range []struct {
+		n     int
+		label string
+	}{{e, labelErr}, {wn, labelWarn}, {n, labelNote}, {fails, labelFail}, {flakyCount(r.Tests), labelFlaky}} {
+		if c.n > 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", c.n, c.label))
+		}
+	}
+	style, icon := t.Warning, t.Icons.Warn
+	if e > 0 || fails > 0 {
+		style, icon = t.Error, t.Icons.Fail
+	}
+	fmt.Fprintf(b, "<h3%s>%s %s — %s</h3>\n",
+		styleAttr(style), icon, html.EscapeString(tool), strings.Join(parts, ", "))
+}
+
+func writeHTMLFindings(b *strings.Builder, fs []report.Finding, t theme.Theme) {
+	b.WriteString("<table>\n<tr><th></th><th>Location</th><th>Rule</th><th>Message</th></tr>\n")
+	for i := range fs {
+		f := &fs[i]
+		style, icon := htmlSeverity(f.Severity, t)
+		loc := ""
+		if f.File != "" {
+			loc = f.File
+			if f.Line > 0 {
+				loc += ":" + strconv.Itoa(f.Line)
+				if f.Col > 0 {
+					loc += ":" + strconv.Itoa(f.Col)
+				}
+			}
+		}
+		fmt.Fprintf(b, "<tr><td%s>%s</td><td><code>%s</code></td><td><code>%s</code></td><td>%s</td></tr>\n",
+			styleAttr(style), icon, html.EscapeString(loc), html.EscapeString(f.RuleID), html.EscapeString(f.Message))
+	}
+	b.WriteString("</table>\n")
+}
+
+func writeHTMLTests(b *strings.Builder, ts []report.TestResult, t theme.Theme) {
+	b.WriteString("<table>\n<tr><th></th><th>Test</th><th>Package</th></tr>\n")
+	var failed []*report.TestResult
+	for i := range ts {
+		tr := &ts[i]
+		if tr.Outcome == report.OutcomePass || tr.Outcome == report.OutcomeSkip {
+			continue
+		}
+		failed = append(failed, tr)
+		name := tr.Test
+		if name == "" {
+			name = string(tr.Outcome)
+		}
+		style, icon := t.Fail, t.Icons.Fail
+		if tr.Flaky {
+			style, icon = t.Warning, t.Icons.Warn
+		}
+		fmt.Fprintf(b, "<tr><td%s>%s</td><td><code>%s</code></td><td><code>%s</code></td></tr>\n",
+			styleAttr(style), icon, html.EscapeString(name), html.EscapeString(tr.Package))
+	}
+	b.WriteString("</table>\n")
+	for _, tr := range failed {
+		out := strings.TrimRight(tr.Output, "\n")
+		if out == "" {
+			continue
+		}
+		name := tr.Test
+		if name == "" {
+			name = tr.Package
+		}
+		fmt.Fprintf(b, "<details><summary>%s</summary><pre>%s</pre></details>\n",
+			html.EscapeString(name), html.EscapeString(out))
+	}
+}
+
+func htmlSeverity(s report.Severity, t theme.Theme) (lipgloss.Style, string) {
+	switch s {
+	case report.SeverityError:
+		return t.Error, t.Icons.Fail
+	case report.SeverityWarning:
+		return t.Warning, t.Icons.Warn
+	default:
+		return t.Note, t.Icons.Note
+	}
+}
+
+// styleAttr translates the parts of a lipgloss style HTML can express —
+// foreground color, bold, faint — into an inline style attribute, or ""
+// when the style sets none of them.
+func styleAttr(s lipgloss.Style) string {
+	var decls []string
+	if c, ok := s.GetForeground().(lipgloss.Color); ok {
+		if hex, ok := cssColor(string(c)); ok {
+			decls = append(decls, "color:"+hex)
+		}
+	}
+	if s.GetBold() {
+		decls = append(decls, "font-weight:bold")
+	}
+	if s.GetFaint() {
+		decls = append(decls, "opacity:0.6")
+	}
+	if len(decls) == 0 {
+		return ""
+	}
+	return ` style="` + strings.Join(decls, ";") + `"`
+}
+
+// ansi16 is the conventional xterm palette for the first 16 ANSI colors.
+var ansi16 = [16]string{
+	"#000000", "#800000", "#008000", "#808000", "#000080", "#800080", "#008080", "#c0c0c0",
+	"#808080", "#ff0000", "#00ff00", "#ffff00", "#0000ff", "#ff00ff", "#00ffff", "#ffffff",
+}
+
+// cssColor converts a lipgloss color spec — "#rrggbb" or an ANSI-256
+// index like "196" — to a CSS hex color using the standard xterm
+// palette (16 system colors, 6×6×6 cube, 24-step gray ramp).
+func cssColor(spec string) (string, bool) {
+	if strings.HasPrefix(spec, "#") {
+		return spec, true
+	}
+	n, err := strconv.Atoi(spec)
+	if err != nil || n < 0 || n > 255 {
+		return "", false
+	}
+	switch {
+	case n < 16:
+		return ansi16[n], true
+	case n < 232:
+		n -= 16
+		level := func(v int) int {
+			if v == 0 {
+				return 0
+			}
+			return 55 + 40*v
+		}
+		return fmt.Sprintf("#%02x%02x%02x", level(n/36), level(n/6%6), level(n%6)), true
+	default:
+		g := 8 + 10*(n-232)
+		return fmt.Sprintf("#%02x%02x%02x", g, g, g), true
+	}
+}
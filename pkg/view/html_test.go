@@ -0,0 +1,107 @@
+package view
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/dkoosis/fo/pkg/report"
+	"github.com/dkoosis/fo/pkg/theme"
+)
+
+func TestRenderHTML_EscapesAndStyles(t *testing.T) {
+	r := report.Report{Tool: "lint", Findings: []report.Finding{
+		{Severity: report.SeverityError, File: "a.go", Line: 3, RuleID: "R1", Message: "<script>alert(1)</script>"},
+	}}
+	var b bytes.Buffer
+	if err := RenderHTML(&b, r, theme.Color()); err != nil {
+		t.Fatalf("RenderHTML: %v", err)
+	}
+	got := b.String()
+	for _, want := range []string{
+		`<div class="fo-report"`,
+		"<code>a.go:3</code>",
+		"&lt;script&gt;alert(1)&lt;/script&gt;",
+		`style="color:#ff0000;font-weight:bold"`, // Color().Error = bold 196
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("missing %q in:\n%s", want, got)
+		}
+	}
+	if strings.Contains(got, "<script>") {
+		t.Errorf("message not escaped:\n%s", got)
+	}
+	if !strings.HasSuffix(got, "</div>\n") {
+		t.Errorf("fragment not closed:\n%s", got)
+	}
+}
+
+func TestRenderHTML_FailedTestOutput(t *testing.T) {
+	r := report.Report{Tests: []report.TestResult{
+		{Package: "x", Test: "TestBad", Outcome: report.OutcomeFail, Output: "got 1 & want 2\n"},
+	}}
+	var b bytes.Buffer
+	if err := RenderHTML(&b, r, theme.Mono()); err != nil {
+		t.Fatalf("RenderHTML: %v", err)
+	}
+	got := b.String()
+	if !strings.Contains(got, "<details><summary>TestBad</summary><pre>got 1 &amp; want 2</pre></details>") {
+		t.Errorf("failure output not folded:\n%s", got)
+	}
+}
+
+func TestRenderHTML_FlakyCountAndGlyph(t *testing.T) {
+	r := report.Report{Tool: "go test", Tests: []report.TestResult{
+		{Package: "x", Test: "TestBad", Outcome: report.OutcomeFail},
+		{Package: "x", Test: "TestFlaky", Outcome: report.OutcomeFail, Flaky: true},
+	}}
+	var b bytes.Buffer
+	if err := RenderHTML(&b, r, theme.Mono()); err != nil {
+		t.Fatalf("RenderHTML: %v", err)
+	}
+	got := b.String()
+	m := theme.Mono()
+	for _, want := range []string{
+		"go test — 2 fail, 1 flaky</h3>",
+		"<td" + styleAttr(m.Warning) + ">" + m.Icons.Warn + "</td><td><code>TestFlaky</code>",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("missing %q in:\n%s", want, got)
+		}
+	}
+}
+
+func TestCSSColor(t *testing.T) {
+	cases := map[string]string{
+		"#123abc": "#123abc",
+		"9":       "#ff0000",
+		"196":     "#ff0000",
+		"34":      "#00af00",
+		"242":     "#6c6c6c",
+	}
+	for in, want := range cases {
+		if got, ok := cssColor(in); !ok || got != want {
+			t.Errorf("cssColor(%q) = %q, %v; want %q", in, got, ok, want)
+		}
+	}
+	if _, ok := cssColor("red"); ok {
+		t.Errorf("named colors are not lipgloss specs; want !ok")
+	}
+}
+
+func TestRenderLeaderboardHTML_BarSpans(t *testing.T) {
+	v := Leaderboard{Total: 4, Rows: []LbRow{{Label: "<a>", Value: 1}}}
+	var b bytes.Buffer
+	if err := RenderLeaderboardHTML(&b, "lint", v, theme.Mono()); err != nil {
+		t.Fatalf("RenderLeaderboardHTML: %v", err)
+	}
+	got := b.String()
+	want := `<td>&lt;a&gt;</td><td><span style="font-weight:bold">#####</span>` +
+		`<span style="opacity:0.6">---------------</span></td>`
+	if !strings.Contains(got, want) {
+		t.Errorf("missing %q in:\n%s", want, got)
+	}
+	if strings.Contains(got, "\x1b") {
+		t.Errorf("HTML output holds terminal escapes:\n%q", got)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io"
 	"strconv"
+	"strings"
 
 	"github.com/dkoosis/fo/pkg/paint"
 	"github.com/dkoosis/fo/pkg/theme"
@@ -15,13 +16,14 @@ import (
 func RenderLeaderboardLLM(w io.Writer, v Leaderboard) error {
 	labelMax := 0
 	for _, r := range v.Rows {
-		if l := len(r.Label); l > labelMax {
+		if l := paint.Width(r.Label); l > labelMax {
 			labelMax = l
 		}
 	}
 	for _, r := range v.Rows {
 		val := strconv.FormatFloat(r.Value, 'f', -1, 64)
-		if _, err := fmt.Fprintf(w, "%-*s  %s\n", labelMax, r.Label, val); err != nil {
+		pad := strings.Repeat(" ", labelMax-paint.Width(r.Label))
+		if _, err := fmt.Fprintf(w, "%s%s  %s\n", r.Label, pad, val); err != nil {
 			return err
 		}
 	}
@@ -47,7 +49,7 @@ func renderLeaderboard(v Leaderboard, t theme.Theme, width int) string {
 	valueMax := 0
 	values := make([]string, len(v.Rows))
 	for i, r := range v.Rows {
-		if l := len(r.Label); l > labelMax {
+		if l := paint.Width(r.Label); l > labelMax {
 			labelMax = l
 		}
 		values[i] = strconv.FormatFloat(r.Value, 'f', -1, 64)
@@ -0,0 +1,269 @@
+package view
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/dkoosis/fo/pkg/report"
+)
+
+// RenderMarkdown writes a Report as GitHub-flavored Markdown for pasting
+// into issues and PR descriptions, where ANSI does not render. Status is
+// carried by emoji rather than color: a heading with the overall verdict
+// and counts, a findings table, and a table of non-passing tests with each
+// failure's output folded into a <details> block.
+//
+// Unlike the terminal views there is no PickView step: a Markdown reader
+// scrolls a document, so the full data is always emitted and GitHub's own
+// table rendering does the aggregation work a bar chart does on a TTY.
+func RenderMarkdown(w io.Writer, r report.Report) error {
+	var b strings.Builder
+	writeMarkdownHeading(&b, r)
+	if r.Diff != nil && r.Diff.Headline != "" {
+		fmt.Fprintf(&b, "\n_%s_\n", mdEscape(r.Diff.Headline))
+	}
+	if len(r.Findings) > 0 {
+		writeMarkdownFindings(&b, r.Findings)
+	}
+	if failCount(r.Tests) > 0 {
+		writeMarkdownTests(&b, r.Tests)
+	}
+	for _, n := range r.Notices {
+		fmt.Fprintf(&b, "\n> ⚠️ %s\n", mdEscape(n))
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func writeMarkdownHeading(b *strings.Builder, r report.Report) {
+	tool := r.Tool
+	if tool == "" {
+		tool = "fo"
+	}
+	if isClean(r) {
+		fmt.Fprintf(b, "### ✅ %s — no findings\n", mdEscape(tool))
+		return
+	}
+	var parts []string
+	e, wn, n := severityCounts(r.Findings)
+	for _, c := range []struct {
+		n     int
+		label string
+	}{{e, labelErr}, {wn, labelWarn}, {n, labelNote}, {failCount(r.Tests), labelFail}, {flakyCount(r.Tests), labelFlaky}} {
+		if c.n > 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", c.n, c.label))
+		}
+	}
+	icon := "⚠️"
+	if e > 0 || failCount(r.Tests) > 0 {
+		icon = "❌"
+	}
+	fmt.Fprintf(b, "### %s %s — %s\n", icon, mdEscape(tool), strings.Join(parts, ", "))
+}
+
+func writeMarkdownFindings(b *strings.Builder, fs []report.Finding) {
+	b.WriteString("\n| | Location | Rule | Message |\n|---|---|---|---|\n")
+	for i := range fs {
+		f := &fs[i]
+		fmt.Fprintf(b, "| %s | %s | %s | %s |\n",
+			mdSeverityIcon(f.Severity), mdLocation(f), mdCode(f.RuleID), mdEscape(f.Message))
+	}
+}
+
+func writeMarkdownTests(b *strings.Builder, ts []report.TestResult) {
+	b.WriteString("\n| | Test | Package |\n|---|---|---|\n")
+	var failed []*report.TestResult
+	for i := range ts {
+		t := &ts[i]
+		if t.Outcome == report.OutcomePass || t.Outcome == report.OutcomeSkip {
+			continue
+		}
+		failed = append(failed, t)
+		name := t.Test
+		if name == "" {
+			name = string(t.Outcome)
+		}
+		icon := "❌"
+		if t.Flaky {
+			icon = "⚠️"
+		}
+		fmt.Fprintf(b, "| %s | %s | %s |\n", icon, mdCode(name), mdCode(t.Package))
+	}
+	for _, t := range failed {
+		out := strings.TrimRight(t.Output, "\n")
+		if out == "" {
+			continue
+		}
+		name := t.Test
+		if name == "" {
+			name = t.Package
+		}
+		// A fence longer than any backtick run in the output keeps the
+		// block from closing early on output that itself contains fences.
+		fence := strings.Repeat("`", max(3, longestRun(out, '`')+1))
+		// <summary> is raw HTML, where Markdown escapes would show
+		// literally, so it takes HTML escaping instead.
+		fmt.Fprintf(b, "\n<details><summary>%s</summary>\n\n%s\n%s\n%s\n\n</details>\n",
+			html.EscapeString(flattenCell(name)), fence, out, fence)
+	}
+}
+
+// RenderLeaderboardMarkdown writes tally rows as a Markdown table under
+// an optional tool heading, in the tally's own (ranked) order.
+func RenderLeaderboardMarkdown(w io.Writer, tool string, v Leaderboard) error {
+	var b strings.Builder
+	writeMarkdownToolHeading(&b, tool)
+	b.WriteString("| Label | Value |\n|---|---:|\n")
+	for _, r := range v.Rows {
+		fmt.Fprintf(&b, "| %s | %s |\n", mdEscape(r.Label), strconv.FormatFloat(r.Value, 'f', -1, 64))
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// RenderStatusMarkdown writes status rows as a Markdown table, one emoji
+// per state, under a heading with the non-zero state counts.
+func RenderStatusMarkdown(w io.Writer, tool string, rows []StatusRow) error {
+	if tool == "" {
+		tool = "fo"
+	}
+	counts := map[string]int{}
+	for _, r := range rows {
+		counts[r.State]++
+	}
+	var parts []string
+	for _, st := range []string{stateOK, stateFail, stateWarn, stateSkip} {
+		if counts[st] > 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", counts[st], st))
+		}
+	}
+	icon := "✅"
+	switch {
+	case counts[stateFail] > 0:
+		icon = "❌"
+	case counts[stateWarn] > 0:
+		icon = "⚠️"
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "### %s %s — %s\n\n", icon, mdEscape(tool), strings.Join(parts, ", "))
+	b.WriteString("| | Label | Value | Note |\n|---|---|---|---|\n")
+	for _, r := range rows {
+		fmt.Fprintf(&b, "| %s | %s | %s | %s |\n",
+			mdStateIcon(r.State), mdEscape(r.Label), mdEscape(r.Value), mdEscape(r.Note))
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// RenderMetricsMarkdown writes metric rows as a Markdown table with the
+// change against the prior run, "(new)" when there was none.
+func RenderMetricsMarkdown(w io.Writer, tool string, rows []MetricRow) error {
+	var b strings.Builder
+	writeMarkdownToolHeading(&b, tool)
+	b.WriteString("| Metric | Value | Change |\n|---|---:|---:|\n")
+	for _, r := range rows {
+		fmt.Fprintf(&b, "| %s | %s | %s |\n", mdCode(r.Key),
+			mdEscape(strconv.FormatFloat(r.Value, 'f', -1, 64)+formatUnit(r.Unit)),
+			strings.TrimSpace(formatDelta(r)))
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func writeMarkdownToolHeading(b *strings.Builder, tool string) {
+	if tool != "" {
+		fmt.Fprintf(b, "### %s\n\n", mdEscape(tool))
+	}
+}
+
+func mdStateIcon(state string) string {
+	switch state {
+	case stateOK:
+		return "✅"
+	case stateFail:
+		return "❌"
+	case stateWarn:
+		return "⚠️"
+	default:
+		return "⏭️"
+	}
+}
+
+func mdSeverityIcon(s report.Severity) string {
+	switch s {
+	case report.SeverityError:
+		return "❌"
+	case report.SeverityWarning:
+		return "⚠️"
+	default:
+		return "ℹ️"
+	}
+}
+
+func mdLocation(f *report.Finding) string {
+	if f.File == "" {
+		return ""
+	}
+	loc := f.File
+	if f.Line > 0 {
+		loc = fmt.Sprintf("%s:%d", loc, f.Line)
+		if f.Col > 0 {
+			loc = fmt.Sprintf("%s:%d", loc, f.Col)
+		}
+	}
+	return mdCode(loc)
+}
+
+// mdCode wraps s in an inline code span, or returns "" for an empty cell.
+// Pipes are escaped even inside code: GFM splits table cells before it
+// parses inline code. Backticks in s would close a single-backtick span,
+// so the fence is one longer than the longest run in s, padded with a
+// space on each side (which the renderer strips) so an edge backtick
+// cannot merge into it.
+func mdCode(s string) string {
+	if s == "" {
+		return ""
+	}
+	s = strings.ReplaceAll(flattenCell(s), "|", `\|`)
+	n := longestRun(s, '`')
+	if n == 0 {
+		return "`" + s + "`"
+	}
+	fence := strings.Repeat("`", n+1)
+	return fence + " " + s + " " + fence
+}
+
+// mdEscaper backslash-escapes the characters GFM would read as markup in
+// running text — emphasis, code spans, cell pipes — and entity-encodes
+// the ones that could open raw HTML.
+var mdEscaper = strings.NewReplacer(
+	`\`, `\\`, "|", `\|`, "*", `\*`, "_", `\_`, "`", "\\`",
+	"&", "&amp;", "<", "&lt;",
+)
+
+// mdEscape makes s safe for a single table cell or heading line: newlines
+// would end the row, and markup characters are escaped so tool output
+// renders as the literal text it is.
+func mdEscape(s string) string {
+	return mdEscaper.Replace(flattenCell(s))
+}
+
+func flattenCell(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+func longestRun(s string, c byte) int {
+	best, cur := 0, 0
+	for i := 0; i < len(s); i++ {
+		if s[i] == c {
+			cur++
+			best = max(best, cur)
+		} else {
+			cur = 0
+		}
+	}
+	return best
+}
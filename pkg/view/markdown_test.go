@@ -0,0 +1,134 @@
+package view
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/dkoosis/fo/pkg/report"
+)
+
+func renderMD(t *testing.T, r report.Report) string {
+	t.Helper()
+	var b bytes.Buffer
+	if err := RenderMarkdown(&b, r); err != nil {
+		t.Fatalf("RenderMarkdown: %v", err)
+	}
+	return b.String()
+}
+
+func TestRenderMarkdown_Clean(t *testing.T) {
+	got := renderMD(t, report.Report{Tool: "golangci-lint"})
+	if got != "### ✅ golangci-lint — no findings\n" {
+		t.Errorf("clean heading: %q", got)
+	}
+}
+
+func TestRenderMarkdown_FindingsTable(t *testing.T) {
+	r := report.Report{Tool: "lint", Findings: []report.Finding{
+		{Severity: report.SeverityError, File: "a.go", Line: 10, Col: 2, RuleID: "SA1000", Message: "bad | regex"},
+		{Severity: report.SeverityWarning, File: "b.go", Line: 5, Message: "line one\nline two"},
+	}}
+	got := renderMD(t, r)
+	for _, want := range []string{
+		"### ❌ lint — 1 err, 1 warn",
+		"| | Location | Rule | Message |",
+		"| ❌ | `a.go:10:2` | `SA1000` | bad \\| regex |",
+		"| ⚠️ | `b.go:5` |  | line one line two |",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("missing %q in:\n%s", want, got)
+		}
+	}
+}
+
+func TestRenderMarkdown_FlakyTest(t *testing.T) {
+	r := report.Report{Tool: "go test", Tests: []report.TestResult{
+		{Package: "x", Test: "TestFlaky", Outcome: report.OutcomeFail, Flaky: true},
+		{Package: "x", Test: "TestBad", Outcome: report.OutcomeFail},
+	}}
+	got := renderMD(t, r)
+	for _, want := range []string{
+		"### ❌ go test — 2 fail, 1 flaky",
+		"| ⚠️ | `TestFlaky` | `x` |",
+		"| ❌ | `TestBad` | `x` |",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("missing %q in:\n%s", want, got)
+		}
+	}
+}
+
+func TestRenderMarkdown_FailedTestsWithOutput(t *testing.T) {
+	r := report.Report{Tool: "go test", Tests: []report.TestResult{
+		{Package: "x", Test: "TestOK", Outcome: report.OutcomePass},
+		{Package: "x", Test: "TestBad", Outcome: report.OutcomeFail, Output: "want 1\n```\ngot 2\n"},
+	}}
+	got := renderMD(t, r)
+	for _, want := range []string{
+		"### ❌ go test — 1 fail",
+		"| ❌ | `TestBad` | `x` |",
+		"<details><summary>TestBad</summary>",
+		"````\nwant 1\n```\ngot 2\n````",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("missing %q in:\n%s", want, got)
+		}
+	}
+	if strings.Contains(got, "TestOK") {
+		t.Errorf("passing test should not be listed:\n%s", got)
+	}
+}
+
+func TestRenderStatusMarkdown(t *testing.T) {
+	var b bytes.Buffer
+	rows := []StatusRow{
+		{State: stateOK, Label: "env"},
+		{State: stateWarn, Label: "disk", Value: "91%", Note: "a | b"},
+	}
+	if err := RenderStatusMarkdown(&b, "doctor", rows); err != nil {
+		t.Fatalf("RenderStatusMarkdown: %v", err)
+	}
+	got := b.String()
+	for _, want := range []string{
+		"### ⚠️ doctor — 1 ok, 1 warn\n",
+		"| ✅ | env |  |  |\n",
+		"| ⚠️ | disk | 91% | a \\| b |\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("missing %q in:\n%s", want, got)
+		}
+	}
+}
+
+func TestMdCode(t *testing.T) {
+	for _, tc := range []struct{ in, want string }{
+		{"", ""},
+		{"SA1000", "`SA1000`"},
+		{"a|b", "`a\\|b`"},
+		{"use `x`", "`` use `x` ``"},
+		{"`edge", "`` `edge ``"},
+		{"a``b", "``` a``b ```"},
+	} {
+		if got := mdCode(tc.in); got != tc.want {
+			t.Errorf("mdCode(%q) = %q, want %q", tc.in, got, tc.want)
+		}
+	}
+}
+
+func TestMdEscape(t *testing.T) {
+	for _, tc := range []struct{ in, want string }{
+		{"plain text", "plain text"},
+		{"a | b", `a \| b`},
+		{"*ptr is nil", `\*ptr is nil`},
+		{"snake_case_name", `snake\_case\_name`},
+		{"call `f`", "call \\`f\\`"},
+		{"<script> & co", "&lt;script> &amp; co"},
+		{`C:\dir`, `C:\\dir`},
+		{"line one\nline two", "line one line two"},
+	} {
+		if got := mdEscape(tc.in); got != tc.want {
+			t.Errorf("mdEscape(%q) = %q, want %q", tc.in, got, tc.want)
+		}
+	}
+}
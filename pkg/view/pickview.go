@@ -35,10 +35,11 @@ const (
 
 // Bucket labels used in summary chrome (delta buckets, severity counters).
 const (
-	labelErr  = "err"
-	labelWarn = "warn"
-	labelNote = "note"
-	labelFail = "fail"
+	labelErr   = "err"
+	labelWarn  = "warn"
+	labelNote  = "note"
+	labelFail  = "fail"
+	labelFlaky = "flaky"
 )
 
 // PickView selects a ViewSpec from a Report. Pure and deterministic:
@@ -64,6 +65,14 @@ func PickViewModeWithExpand(r report.Report, mode Mode, expand expandSet) ViewSp
 			return Delta{Inner: inner, Buckets: buckets, Headline: r.Diff.Headline}
 		}
 	}
+	// A flaky test reads like any failure row-by-row; the summary strip
+	// carries the count so a -count=N run says how many were unstable.
+	if fl := flakyCount(r.Tests); fl > 0 {
+		return Delta{Inner: inner, Buckets: []DeltaBucket{
+			{Label: labelFail, Count: failCount(r.Tests)},
+			{Label: labelFlaky, Count: fl},
+		}}
+	}
 	return inner
 }
 
@@ -451,8 +460,12 @@ func testItem(t report.TestResult) BulletItem {
 	if label == "" {
 		label = t.Package
 	}
+	if t.Flaky {
+		label += " (flaky)"
+	}
 	return BulletItem{
 		Outcome:    t.Outcome,
+		Flaky:      t.Flaky,
 		ID:         t.ID,
 		Label:      label,
 		Value:      t.Package,
@@ -464,18 +477,23 @@ func testItem(t report.TestResult) BulletItem {
 // Direction is derived from Diff classification (New/Resolved/Regressed
 // per severity); the fail bucket is always 0-direction because state
 // does not persist test outcomes — the bucket stays for layout symmetry.
+// A flaky bucket follows it only when the run had flaky tests.
 func deltaBuckets(cur report.Report, d *report.DiffSummary) []DeltaBucket {
 	curE, curW, curN := severityCounts(cur.Findings)
 	curF := failCount(cur.Tests)
 	dE := severityDelta(d, string(report.SeverityError))
 	dW := severityDelta(d, string(report.SeverityWarning))
 	dN := severityDelta(d, string(report.SeverityNote))
-	return []DeltaBucket{
+	buckets := []DeltaBucket{
 		{Label: labelErr, Count: curE, Direction: sign(dE)},
 		{Label: labelWarn, Count: curW, Direction: sign(dW)},
 		{Label: labelNote, Count: curN, Direction: sign(dN)},
 		{Label: labelFail, Count: curF, Direction: 0},
 	}
+	if fl := flakyCount(cur.Tests); fl > 0 {
+		buckets = append(buckets, DeltaBucket{Label: labelFlaky, Count: fl})
+	}
+	return buckets
 }
 
 func severityDelta(d *report.DiffSummary, sev string) int {
@@ -527,6 +545,17 @@ func failCount(ts []report.TestResult) int {
 	return c
 }
 
+// flakyCount is the subset of failCount that also passed within the run.
+func flakyCount(ts []report.TestResult) int {
+	var c int
+	for i := range ts {
+		if ts[i].Flaky {
+			c++
+		}
+	}
+	return c
+}
+
 func sign(n int) int {
 	switch {
 	case n > 0:
@@ -219,6 +219,23 @@ func TestPickView_Delta_NoChange_NoWrap(t *testing.T) {
 	}
 }
 
+func TestPickView_FlakyCountInSummaryStrip(t *testing.T) {
+	r := report.Report{Tests: []report.TestResult{
+		{Package: "p", Test: "TestA", Outcome: report.OutcomeFail},
+		{Package: "p", Test: "TestB", Outcome: report.OutcomeFail, Flaky: true},
+	}}
+	for _, mode := range []view.Mode{view.ModeHuman, view.ModeLLM} {
+		d, ok := view.PickViewMode(r, mode).(view.Delta)
+		if !ok {
+			t.Fatalf("mode %d: want Delta strip for a flaky run, got %T", mode, view.PickViewMode(r, mode))
+		}
+		want := []view.DeltaBucket{{Label: "fail", Count: 2}, {Label: "flaky", Count: 1}}
+		if fmt.Sprint(d.Buckets) != fmt.Sprint(want) {
+			t.Errorf("mode %d: buckets = %v, want %v", mode, d.Buckets, want)
+		}
+	}
+}
+
 func TestPickView_Determinism(t *testing.T) {
 	r := report.Report{Findings: mkFindings(7, report.SeverityWarning, "a")}
 	a := view.PickView(r)
@@ -121,7 +121,7 @@ func renderCommand(w io.Writer, cmd scene.Command, t theme.Theme) error {
 // non-TTY) the foreground is dropped so the actor appears in plain
 // bold (fo-5r4).
 func actorStyle(actor string, t theme.Theme) lipgloss.Style {
-	if t.Name == "mono" {
+	if t.Colorless() {
 		return lipgloss.NewStyle().Bold(true)
 	}
 	h := fnv.New32a()
@@ -6,16 +6,13 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/dkoosis/fo/pkg/ansi"
 	"github.com/dkoosis/fo/pkg/scene"
 	"github.com/dkoosis/fo/pkg/view"
 )
 
 const frugalLapwing = "FrugalLapwing"
 
-var ansiRE = regexp.MustCompile(`\x1b\[[0-9;]*m`)
-
-func stripANSI(s string) string { return ansiRE.ReplaceAllString(s, "") }
-
 func TestRenderSceneHuman(t *testing.T) {
 	s := scene.Scene{
 		Title:  "Demo",
@@ -50,7 +47,7 @@ func TestRenderSceneHuman(t *testing.T) {
 	if err := view.RenderSceneHuman(&buf, s); err != nil {
 		t.Fatalf("render: %v", err)
 	}
-	plain := stripANSI(buf.String())
+	plain := ansi.Strip(buf.String())
 
 	for _, want := range []string{
 		"Demo",
@@ -56,6 +56,15 @@ func RenderStream(ctx context.Context, w io.Writer, ch <-chan report.Report, t t
 // end-of-stream, only if no non-clean snapshot ever rendered. Any
 // non-clean snapshot discards a pending Clean heartbeat.
 func RenderStreamMode(ctx context.Context, w io.Writer, ch <-chan report.Report, t theme.Theme, width int, mode Mode) error {
+	return RenderStreamSized(ctx, w, ch, t, func() int { return width }, mode)
+}
+
+// RenderStreamSized is RenderStreamMode with the width re-read before each
+// snapshot, so a live stream follows terminal resizes. Snapshots are
+// appended, never redrawn, so there is nothing to repaint on SIGWINCH
+// itself; querying at render time keeps every new snapshot right on any
+// platform without a signal handler.
+func RenderStreamSized(ctx context.Context, w io.Writer, ch <-chan report.Report, t theme.Theme, width func() int, mode Mode) error {
 	first := true
 	var pendingClean *report.Report
 	rendered := false
@@ -84,7 +93,7 @@ type streamStep struct {
 	rendered bool
 }
 
-func handleSnapshot(w io.Writer, r report.Report, t theme.Theme, width int, first *bool, mode Mode, pending *report.Report) (streamStep, error) {
+func handleSnapshot(w io.Writer, r report.Report, t theme.Theme, width func() int, first *bool, mode Mode, pending *report.Report) (streamStep, error) {
 	if _, isClean := PickViewMode(r, mode).(Clean); isClean {
 		snap := r
 		return streamStep{pending: &snap}, nil
@@ -96,7 +105,7 @@ func handleSnapshot(w io.Writer, r report.Report, t theme.Theme, width int, firs
 	return streamStep{rendered: true}, nil
 }
 
-func flushStream(w io.Writer, pendingClean *report.Report, t theme.Theme, width int, first *bool, mode Mode, rendered bool) error {
+func flushStream(w io.Writer, pendingClean *report.Report, t theme.Theme, width func() int, first *bool, mode Mode, rendered bool) error {
 	if pendingClean != nil && !rendered {
 		return writeSnapshot(w, *pendingClean, t, width, first, mode)
 	}
@@ -105,8 +114,8 @@ func flushStream(w io.Writer, pendingClean *report.Report, t theme.Theme, width
 
 // writeSnapshot renders one report snapshot and writes it to w, prepending a
 // blank separator line for all but the first snapshot.
-func writeSnapshot(w io.Writer, r report.Report, t theme.Theme, width int, first *bool, mode Mode) error {
-	out := Render(PickViewMode(r, mode), t, width)
+func writeSnapshot(w io.Writer, r report.Report, t theme.Theme, width func() int, first *bool, mode Mode) error {
+	out := Render(PickViewMode(r, mode), t, width())
 	if out == "" {
 		return nil
 	}
@@ -230,3 +230,26 @@ func TestRenderStream_ContextCancel(t *testing.T) {
 		t.Fatal("RenderStream did not return after cancel")
 	}
 }
+
+// TestRenderStreamSized_RequeriesWidthPerSnapshot — a live stream picks up
+// terminal resizes: the width func is consulted for every snapshot rather
+// than captured once at start.
+func TestRenderStreamSized_RequeriesWidthPerSnapshot(t *testing.T) {
+	widths := []int{80, 120}
+	var calls int
+	width := func() int {
+		w := widths[min(calls, len(widths)-1)]
+		calls++
+		return w
+	}
+	ch := make(chan report.Report, 2)
+	ch <- sampleReport()
+	ch <- sampleReport()
+	close(ch)
+	if err := view.RenderStreamSized(context.Background(), io.Discard, ch, theme.Mono(), width, view.ModeHuman); err != nil {
+		t.Fatalf("stream: %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("width queried %d times, want once per snapshot (2)", calls)
+	}
+}
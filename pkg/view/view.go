@@ -45,6 +45,7 @@ func (Bullet) isViewSpec() {}
 type BulletItem struct {
 	Severity   report.Severity // optional — drives glyph + color
 	Outcome    report.TestOutcome
+	Flaky      bool   // failing test that also passed this run — warn glyph, not fail
 	ID         string // optional short handle (F-7a2 / T-3f1) for `fo explain`
 	Label      string
 	Value      string // free-form right-side detail (e.g. file:line)
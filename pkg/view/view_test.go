@@ -113,6 +113,21 @@ func TestBullet_WithFix_Mono(t *testing.T) {
 	assertGolden(t, "bullet_with_fix", out)
 }
 
+func TestBullet_FlakyTakesWarnGlyph(t *testing.T) {
+	items := []view.BulletItem{
+		{Outcome: report.OutcomeFail, Flaky: true, Label: "TestFlaky (flaky)", Value: "p"},
+		{Outcome: report.OutcomeFail, Label: "TestBad", Value: "p"},
+	}
+	lines := strings.Split(renderMono(view.Bullet{Items: items}, 80), "\n")
+	warn := theme.Mono().Icons.Warn
+	if !strings.Contains(lines[0], warn) {
+		t.Errorf("flaky row = %q, want warn glyph %q", lines[0], warn)
+	}
+	if strings.Contains(lines[1], warn) {
+		t.Errorf("failing row = %q, should keep the fail glyph", lines[1])
+	}
+}
+
 func TestBullet_Color_HasRed(t *testing.T) {
 	out := renderColor(view.Bullet{Items: sampleBulletItems()}, 80)
 	if !strings.Contains(out, escRed) {
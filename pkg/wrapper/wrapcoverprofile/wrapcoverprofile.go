@@ -12,6 +12,10 @@
 //	name.go:startLine.startCol,endLine.endCol numStmt count
 //
 // A count of 0 means the block's statements never executed.
+//
+// ConvertByPackage is the rollup counterpart: instead of locations it
+// aggregates statement coverage per package and emits fo:metrics, sorted
+// lowest-coverage-first so the packages most in need of tests lead.
 package wrapcoverprofile
 
 import (
@@ -19,6 +23,8 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"path"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -55,6 +61,117 @@ func Convert(r io.Reader, w io.Writer) error {
 	return err
 }
 
+// ConvertByPackage reads a coverprofile from r and writes fo:metrics to w:
+// one "<package> <pct> %" row per package, lowest coverage first, then a
+// "total" row. Blocks repeated across test binaries (-coverpkg runs list
+// the same block once per binary) count once, covered if any run hit them.
+func ConvertByPackage(r io.Reader, w io.Writer) error {
+	blocks := map[string]pkgBlock{}
+	br := bufio.NewReaderSize(r, 64*1024)
+	for {
+		line, oversize, err := lineread.Read(br)
+		if !oversize {
+			addPkgBlock(blocks, string(line))
+		}
+		if err == nil {
+			continue
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		return fmt.Errorf("reading coverprofile: %w", err)
+	}
+	return writePkgMetrics(w, rollup(blocks))
+}
+
+// pkgBlock is one deduplicated coverprofile block.
+type pkgBlock struct {
+	pkg     string
+	stmts   int
+	covered bool
+}
+
+// pkgCoverage is the per-package statement tally.
+type pkgCoverage struct {
+	pkg        string
+	stmts, hit int
+}
+
+func (c pkgCoverage) pct() float64 {
+	if c.stmts == 0 {
+		return 0
+	}
+	return float64(c.hit) / float64(c.stmts) * 100
+}
+
+// addPkgBlock records one coverprofile line keyed by its location, so a
+// block listed by several test binaries is merged rather than double
+// counted.
+func addPkgBlock(blocks map[string]pkgBlock, line string) {
+	file, _, _, stmts, count, ok := parseBlock(line)
+	if !ok {
+		return
+	}
+	key := strings.Fields(strings.TrimSpace(line))[0]
+	b := blocks[key]
+	b.pkg = path.Dir(file)
+	b.stmts = stmts
+	b.covered = b.covered || count > 0
+	blocks[key] = b
+}
+
+// rollup folds blocks into per-package coverage sorted lowest first (ties
+// by package name, for stable output).
+func rollup(blocks map[string]pkgBlock) []pkgCoverage {
+	byPkg := map[string]*pkgCoverage{}
+	for _, b := range blocks {
+		c, ok := byPkg[b.pkg]
+		if !ok {
+			c = &pkgCoverage{pkg: b.pkg}
+			byPkg[b.pkg] = c
+		}
+		c.stmts += b.stmts
+		if b.covered {
+			c.hit += b.stmts
+		}
+	}
+	out := make([]pkgCoverage, 0, len(byPkg))
+	for _, c := range byPkg {
+		out = append(out, *c)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if pi, pj := out[i].pct(), out[j].pct(); pi != pj {
+			return pi < pj
+		}
+		return out[i].pkg < out[j].pkg
+	})
+	return out
+}
+
+func writePkgMetrics(w io.Writer, pkgs []pkgCoverage) error {
+	if _, err := fmt.Fprintln(w, "# fo:metrics tool=cover"); err != nil {
+		return err
+	}
+	var total pkgCoverage
+	for _, c := range pkgs {
+		if _, err := fmt.Fprintf(w, "%s %s %%\n", c.pkg, formatPct(c.pct())); err != nil {
+			return err
+		}
+		total.stmts += c.stmts
+		total.hit += c.hit
+	}
+	if len(pkgs) == 0 {
+		return nil
+	}
+	_, err := fmt.Fprintf(w, "total %s %%\n", formatPct(total.pct()))
+	return err
+}
+
+// formatPct rounds to one decimal place, matching `go test -cover`.
+func formatPct(v float64) string {
+	return strconv.FormatFloat(v, 'f', 1, 64)
+}
+
 // addBlock parses one coverprofile line and, when it describes an
 // uncovered block, appends a note finding. Malformed lines and the
 // `mode:` header are ignored.
@@ -94,3 +94,37 @@ func TestParseBlock(t *testing.T) {
 		})
 	}
 }
+
+func TestConvertByPackage_LowestFirstWithTotal(t *testing.T) {
+	in := "mode: set\n" +
+		"github.com/x/a/a.go:1.1,3.2 3 1\n" +
+		"github.com/x/a/a.go:5.1,6.2 1 0\n" + // a: 3/4 = 75%
+		"github.com/x/b/b.go:1.1,2.2 2 0\n" +
+		"github.com/x/b/b.go:4.1,5.2 2 1\n" // b: 2/4 = 50%
+	var out bytes.Buffer
+	if err := ConvertByPackage(strings.NewReader(in), &out); err != nil {
+		t.Fatalf("ConvertByPackage: %v", err)
+	}
+	want := "# fo:metrics tool=cover\n" +
+		"github.com/x/b 50.0 %\n" +
+		"github.com/x/a 75.0 %\n" +
+		"total 62.5 %\n"
+	if got := out.String(); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestConvertByPackage_MergesDuplicateBlocks(t *testing.T) {
+	// -coverpkg runs list the same block once per test binary; a block is
+	// covered if any binary hit it, and its statements count once.
+	in := "mode: set\n" +
+		"github.com/x/a/a.go:1.1,3.2 4 0\n" +
+		"github.com/x/a/a.go:1.1,3.2 4 1\n"
+	var out bytes.Buffer
+	if err := ConvertByPackage(strings.NewReader(in), &out); err != nil {
+		t.Fatalf("ConvertByPackage: %v", err)
+	}
+	if !strings.Contains(out.String(), "github.com/x/a 100.0 %") {
+		t.Errorf("duplicate block not merged:\n%s", out.String())
+	}
+}
@@ -27,9 +27,16 @@ var (
 const (
 	toolGolangciLint = "golangci-lint"
 	toolGofmt        = "gofmt"
+	toolGofumpt      = "gofumpt"
 	toolGoimports    = "goimports"
 )
 
+// fileOnlyMsg is the message for a bare file path (a formatter's -l
+// output). gofumpt and goimports list files the same way as gofmt but
+// need a different fix, so for them the message names the tool; gofmt
+// keeps the bare message so existing finding fingerprints stay stable.
+const fileOnlyMsg = "needs formatting"
+
 // diag converts line-based diagnostics to SARIF. The string fields
 // previously held *string from a flag.FlagSet plumbing path that has
 // since been removed; the value form removes a layer of read-only
@@ -74,7 +81,7 @@ func (d *diag) readAndAdd(r io.Reader, b *sarif.Builder) error {
 		if oversize {
 			dropped++
 		} else {
-			d.addLine(b, line)
+			d.addLine(b, lineread.LastSegment(line))
 		}
 		if err == nil {
 			continue
@@ -103,6 +110,9 @@ func (d *diag) addLine(b *sarif.Builder, line []byte) {
 	if file == "" {
 		return
 	}
+	if ln == 0 && msg == fileOnlyMsg && (d.toolName == toolGofumpt || d.toolName == toolGoimports) {
+		msg = "needs " + d.toolName + " formatting"
+	}
 	fixCmd := fixCommandFor(d.toolName, d.ruleID, file)
 	b.AddResultWithFix(d.ruleID, d.level, msg, file, ln, col, fixCmd)
 }
@@ -122,6 +132,8 @@ func fixCommandFor(tool, ruleID, file string) string {
 		return fmt.Sprintf("%s run --fix --enable-only=%s %s", toolGolangciLint, ruleID, file)
 	case toolGofmt:
 		return toolGofmt + " -w " + file
+	case toolGofumpt:
+		return toolGofumpt + " -w " + file
 	case toolGoimports:
 		return toolGoimports + " -w " + file
 	default:
@@ -163,7 +175,7 @@ func parseDiagLine(line string) (file string, ln, col int, msg string) {
 
 	trimmed := strings.TrimSpace(line)
 	if strings.HasSuffix(trimmed, ".go") && !strings.Contains(trimmed, " ") {
-		return trimmed, 0, 0, "needs formatting"
+		return trimmed, 0, 0, fileOnlyMsg
 	}
 
 	return "", 0, 0, ""
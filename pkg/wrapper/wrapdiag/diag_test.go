@@ -93,6 +93,51 @@ func TestDiag_FileOnly(t *testing.T) {
 	}
 }
 
+func TestDiag_FileOnlyNamesFormatter(t *testing.T) {
+	for tool, want := range map[string]string{
+		toolGofmt:     "needs formatting",
+		toolGofumpt:   "needs gofumpt formatting",
+		toolGoimports: "needs goimports formatting",
+	} {
+		buf, err := diagConvert(t, []string{flagTool, tool}, "main.go\n")
+		if err != nil {
+			t.Fatal(err)
+		}
+		var doc sarif.Document
+		if err := json.Unmarshal(buf.Bytes(), &doc); err != nil {
+			t.Fatalf("output is not valid JSON: %v", err)
+		}
+		r := doc.Runs[0].Results[0]
+		if r.Message.Text != want {
+			t.Errorf("--tool %s: message = %q, want %q", tool, r.Message.Text, want)
+		}
+		if len(r.Fixes) == 0 {
+			t.Errorf("--tool %s: expected a fix command", tool)
+		}
+	}
+}
+
+func TestDiag_CarriageReturnProgressCollapsed(t *testing.T) {
+	// A progress bar redrawn with \r ahead of the diagnostic on the same
+	// line, plus a CRLF ending: only the final segment is parsed.
+	input := "building  10%\rbuilding 100%\rmain.go:3:1: undefined: x\r\n"
+	buf, err := diagConvert(t, []string{flagTool, "build"}, input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var doc sarif.Document
+	if err := json.Unmarshal(buf.Bytes(), &doc); err != nil {
+		t.Fatalf("output is not valid JSON: %v", err)
+	}
+	if len(doc.Runs[0].Results) != 1 {
+		t.Fatalf("expected 1 result, got %d", len(doc.Runs[0].Results))
+	}
+	r := doc.Runs[0].Results[0]
+	if r.Message.Text != "undefined: x" || r.Locations[0].PhysicalLocation.ArtifactLocation.URI != "main.go" {
+		t.Errorf("got %q at %q", r.Message.Text, r.Locations[0].PhysicalLocation.ArtifactLocation.URI)
+	}
+}
+
 func TestDiag_MissingToolFlag(t *testing.T) {
 	var buf bytes.Buffer
 	err := Convert(strings.NewReader("x.go:1: msg\n"), &buf, DiagOpts{})
@@ -182,6 +227,7 @@ func TestFixCommandFor(t *testing.T) {
 		{toolGolangciLint, "finding", mainGoName, "golangci-lint run --fix main.go"},
 		{toolGolangciLint, "", mainGoName, "golangci-lint run --fix main.go"},
 		{toolGofmt, "x", aGoName, "gofmt -w a.go"},
+		{toolGofumpt, "x", aGoName, "gofumpt -w a.go"},
 		{"goimports", "x", aGoName, "goimports -w a.go"},
 		{"govulncheck", "x", aGoName, ""},
 		{"unknown", "x", aGoName, ""},
@@ -0,0 +1,172 @@
+// Package wrapdocker converts `docker build` output into fo's status
+// format: one row per Dockerfile step. The status view's own header
+// (N ok · N fail · …) is the build summary, so no total row is added.
+//
+// Both output styles are read:
+//
+//	Step 3/12 : RUN go build ./...        classic builder
+//	 ---> Using cache
+//	#7 [3/12] RUN go build ./...          BuildKit, --progress=plain
+//	#7 CACHED
+//	#7 DONE 12.4s
+//
+// A step is ok once it completes (value "cached" or its BuildKit
+// duration), fail when the build reports an error for it (the error text
+// becomes the note), and skip if the output ends before it finishes.
+// BuildKit's bookkeeping steps ("[internal] load ...", "exporting to
+// image") are not Dockerfile steps and are dropped. No total build time
+// is reported: BuildKit prints none, and summing step durations would
+// overstate it whenever stages run in parallel.
+package wrapdocker
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/dkoosis/fo/internal/lineread"
+	"github.com/dkoosis/fo/pkg/status"
+)
+
+// ErrNoSteps is returned when stdin holds no recognizable build steps —
+// an empty status table would read as a clean build.
+var ErrNoSteps = errors.New("wrap docker: no build steps on stdin")
+
+var (
+	classicStepRe = regexp.MustCompile(`^Step (\d+/\d+) : (.*)$`)
+	kitStepRe     = regexp.MustCompile(`^#(\d+) \[([^\]]+)\] (.*)$`)
+	kitEventRe    = regexp.MustCompile(`^#(\d+) (CACHED|DONE ([\d.]+)s|ERROR: .*|CANCELED)$`)
+)
+
+type step struct {
+	label string
+	state status.State // "" until the step finishes
+	value string
+	note  string
+}
+
+type parser struct {
+	steps []*step
+	byID  map[string]*step // BuildKit "#N" → step
+	cur   *step            // classic: the step in progress
+}
+
+// Convert reads docker build output from r and writes fo:status to w.
+func Convert(r io.Reader, w io.Writer) error {
+	p := &parser{byID: map[string]*step{}}
+	br := bufio.NewReaderSize(r, 64*1024)
+	var dropped int
+	for {
+		raw, oversize, err := lineread.Read(br)
+		if oversize {
+			dropped++
+		} else {
+			p.addLine(string(lineread.LastSegment(raw)))
+		}
+		if err == nil {
+			continue
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		return fmt.Errorf("wrap docker: read: %w", err)
+	}
+	if dropped > 0 {
+		fmt.Fprintf(os.Stderr, "wrap docker: dropped %d line(s) exceeding %d bytes\n", dropped, lineread.MaxLineLen)
+	}
+	if len(p.steps) == 0 {
+		return ErrNoSteps
+	}
+	p.finish()
+	return p.write(w)
+}
+
+func (p *parser) addLine(line string) {
+	if m := classicStepRe.FindStringSubmatch(line); m != nil {
+		// The classic builder only moves on after a step succeeds.
+		p.complete(p.cur)
+		p.cur = &step{label: "[" + m[1] + "] " + m[2]}
+		p.steps = append(p.steps, p.cur)
+		return
+	}
+	if m := kitStepRe.FindStringSubmatch(line); m != nil {
+		if m[2] == "internal" || p.byID[m[1]] != nil {
+			return
+		}
+		s := &step{label: "[" + m[2] + "] " + m[3]}
+		p.byID[m[1]] = s
+		p.steps = append(p.steps, s)
+		return
+	}
+	if m := kitEventRe.FindStringSubmatch(line); m != nil {
+		if s := p.byID[m[1]]; s != nil {
+			p.kitEvent(s, m[2], m[3])
+		}
+		return
+	}
+	p.classicLine(strings.TrimSpace(line))
+}
+
+func (p *parser) kitEvent(s *step, event, secs string) {
+	switch {
+	case event == "CACHED":
+		s.state, s.value = status.StateOK, "cached"
+	case strings.HasPrefix(event, "DONE"):
+		if s.state == "" {
+			s.state, s.value = status.StateOK, secs+"s"
+		}
+	case event == "CANCELED":
+		s.state, s.note = status.StateSkip, "canceled"
+	default: // ERROR: ...
+		s.state, s.note = status.StateFail, strings.TrimPrefix(event, "ERROR: ")
+	}
+}
+
+func (p *parser) classicLine(line string) {
+	if p.cur == nil {
+		return
+	}
+	switch {
+	case line == "---> Using cache":
+		p.cur.value = "cached"
+	case strings.HasPrefix(line, "Successfully built"):
+		p.complete(p.cur)
+	case strings.Contains(line, "returned a non-zero code"), strings.HasPrefix(line, "ERROR"):
+		p.cur.state, p.cur.note = status.StateFail, line
+	}
+}
+
+func (p *parser) complete(s *step) {
+	if s != nil && s.state == "" {
+		s.state = status.StateOK
+	}
+}
+
+// finish marks steps the output never finished as skipped.
+func (p *parser) finish() {
+	for _, s := range p.steps {
+		if s.state == "" {
+			s.state, s.note = status.StateSkip, "did not finish"
+		}
+	}
+}
+
+func (p *parser) write(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintln(bw, status.HeaderPrefix+" tool=docker")
+	for _, s := range p.steps {
+		writeRow(bw, s.state, s.label, s.value, s.note)
+	}
+	return bw.Flush()
+}
+
+// writeRow emits one tab-separated status row; tabs inside fields would
+// shift the columns, so they are flattened to spaces.
+func writeRow(w io.Writer, st status.State, label, value, note string) {
+	flat := strings.NewReplacer("\t", " ")
+	fmt.Fprintf(w, "%s  %s\t%s\t%s\n", st, flat.Replace(label), flat.Replace(value), flat.Replace(note))
+}
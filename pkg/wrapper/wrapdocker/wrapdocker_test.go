@@ -0,0 +1,124 @@
+package wrapdocker
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/dkoosis/fo/pkg/status"
+)
+
+func convert(t *testing.T, in string) status.Status {
+	t.Helper()
+	var out bytes.Buffer
+	if err := Convert(strings.NewReader(in), &out); err != nil {
+		t.Fatalf("Convert: %v", err)
+	}
+	s, err := status.Parse(&out)
+	if err != nil {
+		t.Fatalf("output is not fo:status: %v\n%s", err, out.String())
+	}
+	return s
+}
+
+func TestConvert_BuildKitPlain(t *testing.T) {
+	in := `#1 [internal] load build definition from Dockerfile
+#1 DONE 0.0s
+#4 [1/3] FROM docker.io/library/golang:1.24
+#4 CACHED
+#5 [2/3] COPY . /src
+#5 DONE 0.4s
+#6 [3/3] RUN go build ./...
+#6 0.512 main.go:3:1: syntax error
+#6 ERROR: process "/bin/sh -c go build ./..." did not complete successfully: exit code: 1
+`
+	s := convert(t, in)
+	if s.Tool != "docker" {
+		t.Errorf("tool = %q, want docker", s.Tool)
+	}
+	want := []status.Row{
+		{State: status.StateOK, Label: "[1/3] FROM docker.io/library/golang:1.24", Value: "cached"},
+		{State: status.StateOK, Label: "[2/3] COPY . /src", Value: "0.4s"},
+		{State: status.StateFail, Label: "[3/3] RUN go build ./...",
+			Note: `process "/bin/sh -c go build ./..." did not complete successfully: exit code: 1`},
+	}
+	if len(s.Rows) != len(want) {
+		t.Fatalf("rows = %+v, want %d rows", s.Rows, len(want))
+	}
+	for i := range want {
+		if s.Rows[i] != want[i] {
+			t.Errorf("row %d = %+v, want %+v", i, s.Rows[i], want[i])
+		}
+	}
+}
+
+func TestConvert_Classic(t *testing.T) {
+	in := `Step 1/3 : FROM golang:1.24
+ ---> 3f1b2c
+Step 2/3 : COPY . /src
+ ---> Using cache
+ ---> 9a8b7c
+Step 3/3 : RUN go build ./...
+ ---> Running in 1d2e3f
+Successfully built 4a5b6c
+`
+	s := convert(t, in)
+	if len(s.Rows) != 3 {
+		t.Fatalf("rows = %+v, want 3 steps", s.Rows)
+	}
+	for _, r := range s.Rows {
+		if r.State != status.StateOK {
+			t.Errorf("row %q state = %s, want ok", r.Label, r.State)
+		}
+	}
+	if s.Rows[1].Value != "cached" {
+		t.Errorf("step 2 value = %q, want cached", s.Rows[1].Value)
+	}
+}
+
+func TestConvert_ClassicFailureAndUnfinished(t *testing.T) {
+	in := `Step 1/2 : FROM golang:1.24
+ ---> 3f1b2c
+Step 2/2 : RUN go build ./...
+The command '/bin/sh -c go build ./...' returned a non-zero code: 1
+`
+	s := convert(t, in)
+	if got := s.Rows[1]; got.State != status.StateFail || !strings.Contains(got.Note, "non-zero code: 1") {
+		t.Errorf("failing step = %+v", got)
+	}
+
+	s = convert(t, "Step 1/2 : FROM golang:1.24\n ---> Running in 1d2e3f\n")
+	if got := s.Rows[0]; got.State != status.StateSkip || got.Note != "did not finish" {
+		t.Errorf("unfinished step = %+v, want skip / did not finish", got)
+	}
+}
+
+func TestConvert_CarriageReturnProgress(t *testing.T) {
+	// Each progress redraw overwrites the last with '\r'; only the final
+	// frame on a line is what the build actually reported.
+	in := "#4 [1/2] FROM docker.io/library/golang:1.24\r\n" +
+		"#4 resolve 0.1s\r#4 sha256:3f1b 12MB / 80MB 0.9s\r#4 DONE 2.3s\r\n" +
+		"#5 [2/2] RUN go build ./...\n" +
+		"#5 0.3 compiling 10%\r#5 1.1 compiling 100%\r#5 ERROR: exit code: 2\n"
+	s := convert(t, in)
+	want := []status.Row{
+		{State: status.StateOK, Label: "[1/2] FROM docker.io/library/golang:1.24", Value: "2.3s"},
+		{State: status.StateFail, Label: "[2/2] RUN go build ./...", Note: "exit code: 2"},
+	}
+	if len(s.Rows) != len(want) {
+		t.Fatalf("rows = %+v, want %d rows", s.Rows, len(want))
+	}
+	for i := range want {
+		if s.Rows[i] != want[i] {
+			t.Errorf("row %d = %+v, want %+v", i, s.Rows[i], want[i])
+		}
+	}
+}
+
+func TestConvert_NoSteps(t *testing.T) {
+	var out bytes.Buffer
+	if err := Convert(strings.NewReader("hello\n"), &out); !errors.Is(err, ErrNoSteps) {
+		t.Errorf("err = %v, want ErrNoSteps", err)
+	}
+}
@@ -0,0 +1,77 @@
+// Package wraperrcheck converts errcheck output into SARIF 2.1.0.
+//
+// errcheck prints one line per unchecked error, the offending call after
+// a tab:
+//
+//	pkg/store.go:42:12:	defer f.Close()
+//
+// Each line becomes a warning under rule "errcheck" whose message quotes
+// the call, so the finding reads as a sentence ("unchecked error:
+// defer f.Close()") rather than a bare code fragment. Grouping by file and
+// counting are left to fo's views, as for every other wrapper.
+package wraperrcheck
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/dkoosis/fo/internal/lineread"
+	"github.com/dkoosis/fo/pkg/sarif"
+)
+
+const (
+	toolName = "errcheck"
+	ruleID   = "errcheck"
+)
+
+// lineRe is non-greedy on the path so a Windows drive letter ("C:\...")
+// stays part of it; the first ":<line>:<col>:" run ends the path.
+var lineRe = regexp.MustCompile(`^(.+?):(\d+):(\d+):\s*(.*)$`)
+
+// Convert reads errcheck output from r and writes SARIF to w. Lines that
+// are not findings (errcheck's own errors, blank lines) are skipped.
+func Convert(r io.Reader, w io.Writer) error {
+	b := sarif.NewBuilder(toolName, "")
+	br := bufio.NewReaderSize(r, 64*1024)
+	var dropped int
+	for {
+		raw, oversize, err := lineread.Read(br)
+		if oversize {
+			dropped++
+		} else {
+			addLine(b, string(raw))
+		}
+		if err == nil {
+			continue
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		return fmt.Errorf("wrap errcheck: read: %w", err)
+	}
+	if dropped > 0 {
+		fmt.Fprintf(os.Stderr, "wrap errcheck: dropped %d line(s) exceeding %d bytes\n", dropped, lineread.MaxLineLen)
+	}
+	_, err := b.WriteTo(w)
+	return err
+}
+
+func addLine(b *sarif.Builder, line string) {
+	m := lineRe.FindStringSubmatch(strings.TrimRight(line, "\r"))
+	if m == nil {
+		return
+	}
+	ln, _ := strconv.Atoi(m[2])
+	col, _ := strconv.Atoi(m[3])
+	msg := "unchecked error"
+	if call := strings.TrimSpace(m[4]); call != "" {
+		msg += ": " + call
+	}
+	b.AddResult(ruleID, sarif.LevelWarning, msg, m[1], ln, col)
+}
@@ -0,0 +1,45 @@
+package wraperrcheck
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestConvert_UncheckedCalls(t *testing.T) {
+	in := "pkg/store.go:42:12:\tdefer f.Close()\n" +
+		"C:\\src\\main.go:7:2:\tos.Remove(tmp)\n" +
+		"error: failed to check packages\n"
+	var out bytes.Buffer
+	if err := Convert(strings.NewReader(in), &out); err != nil {
+		t.Fatalf("Convert: %v", err)
+	}
+	got := out.String()
+	for _, want := range []string{
+		`"name": "errcheck"`,
+		`"ruleId": "errcheck"`,
+		`"uri": "pkg/store.go"`,
+		`"startLine": 42`,
+		`"startColumn": 12`,
+		"unchecked error: defer f.Close()",
+		`"uri": "C:\\src\\main.go"`,
+		"unchecked error: os.Remove(tmp)",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("missing %q in SARIF:\n%s", want, got)
+		}
+	}
+	if n := strings.Count(got, `"ruleId"`); n != 2 {
+		t.Errorf("want 2 results (non-finding line skipped), got %d:\n%s", n, got)
+	}
+}
+
+func TestConvert_EmptyInput(t *testing.T) {
+	var out bytes.Buffer
+	if err := Convert(strings.NewReader(""), &out); err != nil {
+		t.Fatalf("Convert: %v", err)
+	}
+	if strings.Contains(out.String(), "ruleId") {
+		t.Errorf("clean errcheck run should yield no results:\n%s", out.String())
+	}
+}
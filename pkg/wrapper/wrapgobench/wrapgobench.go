@@ -3,6 +3,13 @@
 // (so "BenchmarkFoo  1234 ns/op  56 B/op  2 allocs/op" produces three
 // rows). For benchstat tabular output (delta columns, geomean rows,
 // confidence intervals), see the separate benchstat wrapper.
+//
+// Compare is the two-run form: given a baseline and a new run it emits one
+// row per metric present in both, valued as the percent change of the
+// mean (repeated -count runs are averaged), so regressions read as
+// positive numbers for ns/op, B/op and allocs/op alike. Its rows go out
+// under tool=gobench-delta so the metrics history never diffs a
+// percentage against an absolute value from Convert.
 package wrapgobench
 
 import (
@@ -54,26 +61,125 @@ func Convert(r io.Reader, w io.Writer) error {
 }
 
 func emitBenchRow(w io.Writer, line string) error {
+	for _, r := range parseBenchLine(line) {
+		if _, err := fmt.Fprintf(w, "%s %s %s\n", r.key, r.value, r.unit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// benchRow is one benchmark/metric pair from a result line. value keeps
+// the original token so Convert echoes it byte-for-byte.
+type benchRow struct {
+	key, value, unit string
+}
+
+// parseBenchLine returns the metric rows of one `go test -bench` result
+// line, or nil if the line is not a result.
+func parseBenchLine(line string) []benchRow {
 	m := benchRe.FindStringSubmatch(line)
 	if m == nil {
 		return nil
 	}
 	name := goMaxProcsSuffixRe.ReplaceAllString(m[1], "")
 	fields := strings.Fields(m[2])
+	var rows []benchRow
 	for i := 0; i+1 < len(fields); i += 2 {
 		vTok := fields[i]
 		unit := fields[i+1]
 		if _, err := strconv.ParseFloat(vTok, 64); err != nil {
 			continue
 		}
-		key := fmt.Sprintf("%s/%s", name, unitKey(unit))
-		if _, err := fmt.Fprintf(w, "%s %s %s\n", key, vTok, unit); err != nil {
+		rows = append(rows, benchRow{key: fmt.Sprintf("%s/%s", name, unitKey(unit)), value: vTok, unit: unit})
+	}
+	return rows
+}
+
+// DeltaTool is the metrics tool name Compare writes under.
+const DeltaTool = "gobench-delta"
+
+// Compare reads a baseline run from old and a new run from cur and writes
+// fo:metrics to w: one "<bench>/<metric> <pct> %" row per metric present in
+// both runs, in the order the new run first reports them. Metrics missing
+// from either side are skipped — there is nothing to compare. A metric
+// that rose from a zero baseline has no finite percentage; it is written
+// as "<bench>/<metric>_from_0 <new> <unit>" so the regression stays
+// visible.
+func Compare(old, cur io.Reader, w io.Writer) error {
+	base, _, err := readMeans(old)
+	if err != nil {
+		return fmt.Errorf("wrap gobench: baseline: %w", err)
+	}
+	next, order, err := readMeans(cur)
+	if err != nil {
+		return fmt.Errorf("wrap gobench: read: %w", err)
+	}
+	if _, err := fmt.Fprintln(w, "# fo:metrics tool="+DeltaTool); err != nil {
+		return err
+	}
+	for _, key := range order {
+		b, ok := base[key.key]
+		if !ok {
+			continue
+		}
+		n := next[key.key]
+		var line string
+		switch {
+		case b == 0 && n != 0:
+			line = fmt.Sprintf("%s_from_0 %s %s", key.key, strconv.FormatFloat(n, 'f', -1, 64), key.unit)
+		case b == 0:
+			line = key.key + " 0.0 %"
+		default:
+			line = fmt.Sprintf("%s %s %%", key.key, strconv.FormatFloat((n-b)/b*100, 'f', 1, 64))
+		}
+		if _, err := fmt.Fprintln(w, line); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
+// metricKey is a first-seen metric: its row key and the unit it was
+// reported in.
+type metricKey struct {
+	key, unit string
+}
+
+// readMeans averages each metric across repeated runs (-count=N) and
+// returns the means plus the keys in first-seen order.
+func readMeans(r io.Reader) (map[string]float64, []metricKey, error) {
+	sums := map[string]float64{}
+	counts := map[string]int{}
+	var order []metricKey
+	br := bufio.NewReaderSize(r, 64*1024)
+	for {
+		raw, oversize, err := lineread.Read(br)
+		if !oversize {
+			for _, row := range parseBenchLine(string(raw)) {
+				v, _ := strconv.ParseFloat(row.value, 64)
+				if counts[row.key] == 0 {
+					order = append(order, metricKey{key: row.key, unit: row.unit})
+				}
+				sums[row.key] += v
+				counts[row.key]++
+			}
+		}
+		if err == nil {
+			continue
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		return nil, nil, err
+	}
+	means := make(map[string]float64, len(sums))
+	for k, s := range sums {
+		means[k] = s / float64(counts[k])
+	}
+	return means, order, nil
+}
+
 func unitKey(u string) string {
 	return strings.NewReplacer("/", "_").Replace(u)
 }
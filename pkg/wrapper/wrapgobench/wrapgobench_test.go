@@ -33,3 +33,38 @@ func TestConvert_skipsHeaders(t *testing.T) {
 		t.Errorf("expected header-only output, got: %q", got)
 	}
 }
+
+func TestCompare_PercentChangePerMetric(t *testing.T) {
+	old := "BenchmarkFoo-8  1000  1000 ns/op  64 B/op  2 allocs/op\n" +
+		"BenchmarkFoo-8  1000  1200 ns/op  64 B/op  2 allocs/op\n" + // mean 1100 ns/op
+		"BenchmarkGone-8  10  5 ns/op\n"
+	cur := "BenchmarkFoo-8  1000  990 ns/op  32 B/op  2 allocs/op\n" +
+		"BenchmarkNew-8  10  7 ns/op\n"
+	var out bytes.Buffer
+	if err := Compare(strings.NewReader(old), strings.NewReader(cur), &out); err != nil {
+		t.Fatalf("Compare: %v", err)
+	}
+	want := "# fo:metrics tool=gobench-delta\n" +
+		"BenchmarkFoo/ns_op -10.0 %\n" +
+		"BenchmarkFoo/B_op -50.0 %\n" +
+		"BenchmarkFoo/allocs_op 0.0 %\n"
+	if got := out.String(); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestCompare_RiseFromZeroBaseline(t *testing.T) {
+	old := "BenchmarkFoo-8  1000  100 ns/op  0 B/op  0 allocs/op\n"
+	cur := "BenchmarkFoo-8  1000  100 ns/op  0 B/op  2 allocs/op\n"
+	var out bytes.Buffer
+	if err := Compare(strings.NewReader(old), strings.NewReader(cur), &out); err != nil {
+		t.Fatalf("Compare: %v", err)
+	}
+	want := "# fo:metrics tool=gobench-delta\n" +
+		"BenchmarkFoo/ns_op 0.0 %\n" +
+		"BenchmarkFoo/B_op 0.0 %\n" +
+		"BenchmarkFoo/allocs_op_from_0 2 allocs/op\n"
+	if got := out.String(); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
@@ -0,0 +1,181 @@
+// Package wrapgomod converts `go mod tidy -diff` and `go mod verify` output
+// into SARIF 2.1.0, so module drift shows up as findings on go.mod.
+//
+// tidy -diff prints a unified diff of go.mod and go.sum against their
+// tidy form. Each changed go.mod line becomes one result located at its
+// line in the current file — rule "gomod/add" for a line tidy would add,
+// "gomod/remove" for one it would drop. go.sum changes are hash noise
+// line by line, so they fold into a single "gomod/sum" result per file
+// with add/remove counts. Drift is a warning: it is fixed by running the
+// tool, like a formatting finding.
+//
+// verify prints "all modules verified" on success, which yields an empty
+// (clean) report. A line naming a module whose download no longer matches
+// go.sum — "<mod> <version>: dir has been modified (…)" and its zip and
+// ziphash siblings, or "verifying <mod>@<version>: checksum mismatch" —
+// becomes a "gomod/verify" error, since that is an integrity failure
+// rather than hygiene. Other output, such as "go: downloading …" progress
+// or the indented hash lines under a mismatch, is ignored.
+//
+// The two shapes are told apart by content: input with diff headers is
+// tidy output, anything else is verify output.
+package wrapgomod
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/dkoosis/fo/internal/lineread"
+	"github.com/dkoosis/fo/pkg/sarif"
+)
+
+const (
+	toolName   = "gomod"
+	ruleAdd    = "gomod/add"
+	ruleRemove = "gomod/remove"
+	ruleSum    = "gomod/sum"
+	ruleVerify = "gomod/verify"
+)
+
+var (
+	hunkRe = regexp.MustCompile(`^@@ -(\d+)`)
+	// verifyRe matches a per-module verify failure: "<mod> v<ver>: …" or
+	// "verifying <mod>@<ver>: …".
+	verifyRe = regexp.MustCompile(`^(\S+ v\S+|verifying \S+@\S+): `)
+)
+
+// sumDelta counts changed lines in one go.sum-style file.
+type sumDelta struct {
+	file           string
+	added, removed int
+}
+
+// converter carries the diff position across lines: the file named by the
+// last "+++" header and the current-file line the next hunk line maps to.
+type converter struct {
+	b       *sarif.Builder
+	isDiff  bool
+	file    string
+	line    int
+	sums    []*sumDelta
+	pending []string // verify lines, held until we know the input is not a diff
+}
+
+// Convert reads tidy -diff or verify output from r and writes SARIF to w.
+func Convert(r io.Reader, w io.Writer) error {
+	c := &converter{b: sarif.NewBuilder(toolName, "")}
+	br := bufio.NewReaderSize(r, 64*1024)
+	var dropped int
+	for {
+		raw, oversize, err := lineread.Read(br)
+		if oversize {
+			dropped++
+		} else {
+			c.addLine(strings.TrimRight(string(raw), "\r"))
+		}
+		if err == nil {
+			continue
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		return fmt.Errorf("wrap gomod: read: %w", err)
+	}
+	if dropped > 0 {
+		fmt.Fprintf(os.Stderr, "wrap gomod: dropped %d line(s) exceeding %d bytes\n", dropped, lineread.MaxLineLen)
+	}
+	c.finish()
+	_, err := c.b.WriteTo(w)
+	return err
+}
+
+func (c *converter) addLine(line string) {
+	switch {
+	case strings.HasPrefix(line, "diff "), strings.HasPrefix(line, "--- "):
+		c.isDiff = true
+		return
+	case strings.HasPrefix(line, "+++ "):
+		c.isDiff = true
+		name := strings.Fields(strings.TrimPrefix(line, "+++ "))
+		c.file = ""
+		if len(name) > 0 {
+			c.file = strings.TrimPrefix(name[0], "tidy/")
+		}
+		return
+	}
+	if !c.isDiff {
+		if verifyRe.MatchString(line) {
+			c.pending = append(c.pending, strings.TrimSpace(line))
+		}
+		return
+	}
+	if m := hunkRe.FindStringSubmatch(line); m != nil {
+		c.line, _ = strconv.Atoi(m[1])
+		return
+	}
+	// A blank context line may arrive with its leading space stripped.
+	if line == "" || line[0] == ' ' {
+		c.line++
+		return
+	}
+	switch line[0] {
+	case '-':
+		c.change(ruleRemove, line[1:])
+		c.line++
+	case '+':
+		c.change(ruleAdd, line[1:])
+	}
+}
+
+// change records one added or removed line at the current position.
+func (c *converter) change(rule, text string) {
+	if strings.HasSuffix(c.file, ".sum") {
+		d := c.sum(c.file)
+		if rule == ruleAdd {
+			d.added++
+		} else {
+			d.removed++
+		}
+		return
+	}
+	text = strings.TrimSpace(text)
+	if text == "" || text == ")" || strings.HasSuffix(text, "(") {
+		return
+	}
+	verb := "add"
+	if rule == ruleRemove {
+		verb = "remove"
+	}
+	c.b.AddResult(rule, sarif.LevelWarning, "go mod tidy would "+verb+": "+text, c.file, c.line, 0)
+}
+
+func (c *converter) sum(file string) *sumDelta {
+	for _, d := range c.sums {
+		if d.file == file {
+			return d
+		}
+	}
+	d := &sumDelta{file: file}
+	c.sums = append(c.sums, d)
+	return d
+}
+
+func (c *converter) finish() {
+	for _, d := range c.sums {
+		c.b.AddResult(ruleSum, sarif.LevelWarning,
+			fmt.Sprintf("%s out of date: %d line(s) to add, %d to remove", d.file, d.added, d.removed),
+			d.file, 0, 0)
+	}
+	if c.isDiff {
+		return
+	}
+	for _, p := range c.pending {
+		c.b.AddResult(ruleVerify, sarif.LevelError, p, "go.sum", 0, 0)
+	}
+}
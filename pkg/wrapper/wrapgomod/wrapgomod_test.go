@@ -0,0 +1,105 @@
+package wrapgomod
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+const tidyDiff = `diff current/go.mod tidy/go.mod
+--- current/go.mod
++++ tidy/go.mod
+@@ -3,8 +3,8 @@
+ go 1.24.0
+
+ require (
+-	github.com/old/dep v1.0.0
+ 	github.com/kept/dep v1.2.0
++	golang.org/x/sys v0.38.0 // indirect
+ )
+diff current/go.sum tidy/go.sum
+--- current/go.sum
++++ tidy/go.sum
+@@ -1,4 +1,4 @@
+-github.com/old/dep v1.0.0 h1:abc=
+-github.com/old/dep v1.0.0/go.mod h1:def=
++golang.org/x/sys v0.38.0 h1:ghi=
+ github.com/kept/dep v1.2.0 h1:jkl=
+`
+
+func TestConvert_TidyDiff(t *testing.T) {
+	var out bytes.Buffer
+	if err := Convert(strings.NewReader(tidyDiff), &out); err != nil {
+		t.Fatalf("Convert: %v", err)
+	}
+	got := out.String()
+	for _, want := range []string{
+		`"name": "gomod"`,
+		`"ruleId": "gomod/remove"`,
+		"go mod tidy would remove: github.com/old/dep v1.0.0",
+		`"ruleId": "gomod/add"`,
+		"go mod tidy would add: golang.org/x/sys v0.38.0 // indirect",
+		`"uri": "go.mod"`,
+		`"startLine": 6`,
+		`"ruleId": "gomod/sum"`,
+		"go.sum out of date: 1 line(s) to add, 2 to remove",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("missing %q in SARIF:\n%s", want, got)
+		}
+	}
+	if n := strings.Count(got, `"ruleId"`); n != 3 {
+		t.Errorf("want 3 results (add, remove, one go.sum rollup), got %d:\n%s", n, got)
+	}
+	if strings.Contains(got, `"level": "error"`) {
+		t.Errorf("tidy drift should be warnings:\n%s", got)
+	}
+}
+
+func TestConvert_VerifyClean(t *testing.T) {
+	var out bytes.Buffer
+	if err := Convert(strings.NewReader("all modules verified\n"), &out); err != nil {
+		t.Fatalf("Convert: %v", err)
+	}
+	if strings.Contains(out.String(), "ruleId") {
+		t.Errorf("verified modules should yield no results:\n%s", out.String())
+	}
+}
+
+func TestConvert_VerifyFailure(t *testing.T) {
+	in := "golang.org/x/text v0.3.0: dir has been modified (/go/pkg/mod/golang.org/x/text@v0.3.0)\n"
+	var out bytes.Buffer
+	if err := Convert(strings.NewReader(in), &out); err != nil {
+		t.Fatalf("Convert: %v", err)
+	}
+	got := out.String()
+	for _, want := range []string{`"ruleId": "gomod/verify"`, `"level": "error"`, "dir has been modified"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("missing %q in SARIF:\n%s", want, got)
+		}
+	}
+}
+
+func TestConvert_VerifyIgnoresDownloadNoise(t *testing.T) {
+	in := "go: downloading golang.org/x/text v0.3.0\n" +
+		"go: downloading golang.org/x/mod v0.17.0\n" +
+		"verifying golang.org/x/mod@v0.17.0: checksum mismatch\n" +
+		"\tdownloaded: h1:aaaa=\n" +
+		"\tgo.sum:     h1:bbbb=\n" +
+		"\n" +
+		"SECURITY ERROR\n"
+	var out bytes.Buffer
+	if err := Convert(strings.NewReader(in), &out); err != nil {
+		t.Fatalf("Convert: %v", err)
+	}
+	got := out.String()
+	if n := strings.Count(got, `"ruleId": "gomod/verify"`); n != 1 {
+		t.Errorf("want 1 verify result, got %d:\n%s", n, got)
+	}
+	if !strings.Contains(got, "checksum mismatch") {
+		t.Errorf("missing mismatch result:\n%s", got)
+	}
+	if strings.Contains(got, "downloading") || strings.Contains(got, "SECURITY ERROR") {
+		t.Errorf("noise lines should not become results:\n%s", got)
+	}
+}
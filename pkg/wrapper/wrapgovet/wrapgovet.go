@@ -0,0 +1,169 @@
+// Package wrapgovet converts `go vet -json` output into SARIF 2.1.0.
+//
+// `go vet -json ./...` writes one JSON object per package, each preceded by
+// a "# <import path>" comment line:
+//
+//	# example.com/pkg
+//	{
+//		"example.com/pkg": {
+//			"printf": [
+//				{"posn": "/abs/file.go:10:2", "message": "..."}
+//			]
+//		}
+//	}
+//
+// The analyzer name ("printf", "shadow", …) becomes the SARIF rule ID, so
+// findings group by analyzer downstream exactly as lint rules do. An
+// analyzer that failed to run reports {"error": "..."} in place of the
+// diagnostic list; that surfaces as an error-level finding rather than
+// vanishing.
+//
+// Input that is not vet JSON (plain `go vet` text) falls back to the
+// line-diagnostic parser used by `fo wrap diag`, so piping the wrong mode
+// still yields findings instead of an empty report.
+package wrapgovet
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/dkoosis/fo/internal/boundread"
+	"github.com/dkoosis/fo/pkg/sarif"
+	"github.com/dkoosis/fo/pkg/wrapper/wrapdiag"
+)
+
+const toolName = "govet"
+
+// vetDiagnostic is one entry in an analyzer's diagnostic list.
+type vetDiagnostic struct {
+	Posn    string `json:"posn"`
+	Message string `json:"message"`
+}
+
+// vetError is what an analyzer reports in place of diagnostics when it
+// could not run (e.g. a type-checking failure in the package).
+type vetError struct {
+	Err string `json:"error"`
+}
+
+// Convert reads `go vet -json` output from r and writes SARIF to w. Plain
+// `go vet` text is converted via the wrapdiag line parser instead.
+func Convert(r io.Reader, w io.Writer) error {
+	data, err := boundread.All(r, 0)
+	if err != nil {
+		return fmt.Errorf("wrap govet: read: %w", err)
+	}
+	b := sarif.NewBuilder(toolName, "")
+	if err := addJSON(b, stripComments(data)); err != nil {
+		return wrapdiag.Convert(bytes.NewReader(data), w, wrapdiag.DiagOpts{Tool: toolName, Rule: "vet"})
+	}
+	_, err = b.WriteTo(w)
+	return err
+}
+
+// stripComments drops the "# <package>" header lines vet interleaves
+// between JSON objects so the remainder decodes as a plain JSON stream.
+func stripComments(data []byte) []byte {
+	var out bytes.Buffer
+	out.Grow(len(data))
+	sc := bufio.NewScanner(bytes.NewReader(data))
+	sc.Buffer(make([]byte, 0, 64*1024), len(data)+1)
+	for sc.Scan() {
+		line := sc.Bytes()
+		if bytes.HasPrefix(bytes.TrimSpace(line), []byte("#")) {
+			continue
+		}
+		out.Write(line)
+		out.WriteByte('\n')
+	}
+	return out.Bytes()
+}
+
+// addJSON decodes the stream of per-package objects and appends one result
+// per diagnostic. Packages and analyzers are visited in sorted order so the
+// SARIF output is stable across runs. Any decode failure is returned so the
+// caller can fall back to line parsing.
+func addJSON(b *sarif.Builder, data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	for {
+		var obj map[string]map[string]json.RawMessage
+		if err := dec.Decode(&obj); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		for _, pkg := range sortedKeys(obj) {
+			analyzers := obj[pkg]
+			for _, name := range sortedKeys(analyzers) {
+				if err := addAnalyzer(b, pkg, name, analyzers[name]); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+// addAnalyzer appends the diagnostics (or the run error) of one analyzer.
+func addAnalyzer(b *sarif.Builder, pkg, analyzer string, raw json.RawMessage) error {
+	var diags []vetDiagnostic
+	if err := json.Unmarshal(raw, &diags); err == nil {
+		for _, d := range diags {
+			file, line, col := parsePosn(d.Posn)
+			b.AddResult(analyzer, sarif.LevelWarning, d.Message, file, line, col)
+		}
+		return nil
+	}
+	var ve vetError
+	if err := json.Unmarshal(raw, &ve); err != nil {
+		return fmt.Errorf("analyzer %s in %s: %w", analyzer, pkg, err)
+	}
+	b.AddResult(analyzer, sarif.LevelError, fmt.Sprintf("%s: analyzer failed: %s", pkg, ve.Err), "", 0, 0)
+	return nil
+}
+
+// parsePosn splits a vet position ("file.go:12:5" or "file.go:12") into
+// its parts. The trailing numeric segments are peeled from the right so a
+// Windows drive letter or a colon inside the path survives intact.
+func parsePosn(posn string) (file string, line, col int) {
+	file = posn
+	last, ok := trailingInt(file)
+	if !ok {
+		return posn, 0, 0
+	}
+	file = file[:strings.LastIndexByte(file, ':')]
+	prev, ok := trailingInt(file)
+	if !ok {
+		return file, last, 0
+	}
+	return file[:strings.LastIndexByte(file, ':')], prev, last
+}
+
+// trailingInt parses the segment after the last colon as an int.
+func trailingInt(s string) (int, bool) {
+	i := strings.LastIndexByte(s, ':')
+	if i < 0 {
+		return 0, false
+	}
+	n, err := strconv.Atoi(s[i+1:])
+	if err != nil {
+		return 0, false
+	}
+	return n, true
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,103 @@
+package wrapgovet
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+const vetJSON = `# example.com/a
+{
+	"example.com/a": {
+		"printf": [
+			{
+				"posn": "/src/a/a.go:10:2",
+				"message": "fmt.Printf format %d has arg s of wrong type string"
+			}
+		],
+		"shadow": [
+			{
+				"posn": "/src/a/b.go:7:3",
+				"message": "declaration of \"err\" shadows declaration at line 5"
+			}
+		]
+	}
+}
+# example.com/b
+{
+	"example.com/b": {
+		"unusedresult": {
+			"error": "type-checking failed"
+		}
+	}
+}
+`
+
+func TestConvert_JSONGroupsByAnalyzer(t *testing.T) {
+	var out bytes.Buffer
+	if err := Convert(strings.NewReader(vetJSON), &out); err != nil {
+		t.Fatalf("Convert: %v", err)
+	}
+	got := out.String()
+	for _, want := range []string{
+		`"name": "govet"`,
+		`"ruleId": "printf"`,
+		`"ruleId": "shadow"`,
+		`"uri": "/src/a/a.go"`,
+		`"startLine": 10`,
+		`"startColumn": 2`,
+		"wrong type string",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("missing %q in SARIF:\n%s", want, got)
+		}
+	}
+	// An analyzer that failed to run surfaces as an error, not silence.
+	if !strings.Contains(got, `"ruleId": "unusedresult"`) || !strings.Contains(got, "analyzer failed: type-checking failed") {
+		t.Errorf("analyzer error not surfaced:\n%s", got)
+	}
+}
+
+func TestConvert_FallsBackToLineDiagnostics(t *testing.T) {
+	in := "# example.com/a\na/a.go:10:2: fmt.Printf format %d has arg s of wrong type string\n"
+	var out bytes.Buffer
+	if err := Convert(strings.NewReader(in), &out); err != nil {
+		t.Fatalf("Convert: %v", err)
+	}
+	got := out.String()
+	for _, want := range []string{`"name": "govet"`, `"ruleId": "vet"`, `"uri": "a/a.go"`, `"startLine": 10`} {
+		if !strings.Contains(got, want) {
+			t.Errorf("missing %q in fallback SARIF:\n%s", want, got)
+		}
+	}
+}
+
+func TestConvert_EmptyInput(t *testing.T) {
+	var out bytes.Buffer
+	if err := Convert(strings.NewReader("# example.com/clean\n"), &out); err != nil {
+		t.Fatalf("Convert: %v", err)
+	}
+	if strings.Contains(out.String(), "ruleId") {
+		t.Errorf("clean vet run should yield no results:\n%s", out.String())
+	}
+}
+
+func TestParsePosn(t *testing.T) {
+	cases := []struct {
+		in        string
+		file      string
+		line, col int
+	}{
+		{"a.go:12:5", "a.go", 12, 5},
+		{"a.go:12", "a.go", 12, 0},
+		{`C:\src\a.go:3:1`, `C:\src\a.go`, 3, 1},
+		{"a.go", "a.go", 0, 0},
+	}
+	for _, c := range cases {
+		file, line, col := parsePosn(c.in)
+		if file != c.file || line != c.line || col != c.col {
+			t.Errorf("parsePosn(%q) = (%q, %d, %d), want (%q, %d, %d)",
+				c.in, file, line, col, c.file, c.line, c.col)
+		}
+	}
+}
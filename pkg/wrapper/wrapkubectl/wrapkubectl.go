@@ -0,0 +1,178 @@
+// Package wrapkubectl converts `kubectl apply` and `kubectl diff` output
+// into fo's status format, one row per resource.
+//
+// apply prints "<kind>/<name> <action>" per resource:
+//
+//	deployment.apps/web configured
+//	service/web unchanged
+//	Error from server (NotFound): error when creating "web.yaml": ...
+//
+// Each resource becomes an ok row valued by its action, grouped so errors
+// come first, then created, configured (and other actions), then
+// unchanged. Error lines become fail rows with the message as the note.
+// kubectl itself exits non-zero when a resource errors; status input is
+// informational in fo, so gate on that exit (set -o pipefail), not on fo's.
+//
+// diff prints a unified diff per resource against a temp path named
+// "<group>.<version>.<Kind>.<namespace>.<name>". Each resource becomes a
+// warn row — a pending change — valued "+N -M" by its changed lines.
+// Rendering the diff hunks themselves is left to kubectl: fo renders
+// reports, not diffs.
+//
+// The two shapes are told apart by content: input with "diff " headers
+// is diff output, anything else is apply output.
+package wrapkubectl
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/dkoosis/fo/internal/lineread"
+	"github.com/dkoosis/fo/pkg/status"
+)
+
+// ErrNoResources is returned when stdin names no resources — an empty
+// status table would read as a clean apply.
+var ErrNoResources = errors.New("wrap kubectl: no resources on stdin")
+
+type row struct {
+	state        status.State
+	label, value string
+	note         string
+	added, gone  int // diff mode: changed line counts
+}
+
+type parser struct {
+	rows   []*row
+	isDiff bool
+	cur    *row // diff mode: resource whose hunks are being read
+}
+
+// Convert reads kubectl apply or diff output from r and writes fo:status
+// to w.
+func Convert(r io.Reader, w io.Writer) error {
+	p := &parser{}
+	br := bufio.NewReaderSize(r, 64*1024)
+	var dropped int
+	for {
+		raw, oversize, err := lineread.Read(br)
+		if oversize {
+			dropped++
+		} else {
+			p.addLine(strings.TrimRight(string(raw), "\r"))
+		}
+		if err == nil {
+			continue
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		return fmt.Errorf("wrap kubectl: read: %w", err)
+	}
+	if dropped > 0 {
+		fmt.Fprintf(os.Stderr, "wrap kubectl: dropped %d line(s) exceeding %d bytes\n", dropped, lineread.MaxLineLen)
+	}
+	if len(p.rows) == 0 {
+		return ErrNoResources
+	}
+	return p.write(w)
+}
+
+func (p *parser) addLine(line string) {
+	if strings.HasPrefix(line, "diff ") {
+		p.isDiff = true
+		fields := strings.Fields(line)
+		p.cur = &row{state: status.StateWarn, label: diffLabel(path.Base(fields[len(fields)-1]))}
+		p.rows = append(p.rows, p.cur)
+		return
+	}
+	if p.isDiff {
+		p.diffLine(line)
+		return
+	}
+	p.applyLine(strings.TrimSpace(line))
+}
+
+func (p *parser) diffLine(line string) {
+	if p.cur == nil || strings.HasPrefix(line, "+++ ") || strings.HasPrefix(line, "--- ") {
+		return
+	}
+	switch {
+	case strings.HasPrefix(line, "+"):
+		p.cur.added++
+	case strings.HasPrefix(line, "-"):
+		p.cur.gone++
+	}
+}
+
+func (p *parser) applyLine(line string) {
+	if line == "" {
+		return
+	}
+	if strings.HasPrefix(line, "Error from server") || strings.HasPrefix(line, "error:") {
+		p.rows = append(p.rows, &row{state: status.StateFail, label: "error", note: line})
+		return
+	}
+	// "<kind>/<name> <action>[ (dry run)]"
+	res, action, ok := strings.Cut(line, " ")
+	if !ok || !strings.Contains(res, "/") {
+		return
+	}
+	p.rows = append(p.rows, &row{state: status.StateOK, label: res, value: action})
+}
+
+// diffLabel turns kubectl's temp file name into "<Kind> <ns>/<name>".
+// The group may itself contain dots (networking.k8s.io), so the kind is
+// found as the first capitalized segment; the namespace follows it and
+// everything after is the name, which may contain dots too.
+func diffLabel(base string) string {
+	segs := strings.Split(base, ".")
+	for i, s := range segs {
+		if s == "" || !unicode.IsUpper(rune(s[0])) || i+2 >= len(segs) {
+			continue
+		}
+		ns, name := segs[i+1], strings.Join(segs[i+2:], ".")
+		if ns == "" {
+			return s + " " + name
+		}
+		return s + " " + ns + "/" + name
+	}
+	return base
+}
+
+// rank orders rows: failures first, then created, other actions, and
+// unchanged last, so the rows that need attention lead.
+func rank(r *row) int {
+	switch {
+	case r.state == status.StateFail:
+		return 0
+	case strings.HasPrefix(r.value, "created"):
+		return 1
+	case strings.HasPrefix(r.value, "unchanged"):
+		return 3
+	default:
+		return 2
+	}
+}
+
+func (p *parser) write(w io.Writer) error {
+	sort.SliceStable(p.rows, func(i, j int) bool { return rank(p.rows[i]) < rank(p.rows[j]) })
+	bw := bufio.NewWriter(w)
+	fmt.Fprintln(bw, status.HeaderPrefix+" tool=kubectl")
+	flat := strings.NewReplacer("\t", " ")
+	for _, r := range p.rows {
+		value := r.value
+		if p.isDiff {
+			value = fmt.Sprintf("+%d -%d", r.added, r.gone)
+		}
+		fmt.Fprintf(bw, "%s  %s\t%s\t%s\n", r.state, flat.Replace(r.label), flat.Replace(value), flat.Replace(r.note))
+	}
+	return bw.Flush()
+}
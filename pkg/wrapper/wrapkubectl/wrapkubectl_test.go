@@ -0,0 +1,90 @@
+package wrapkubectl
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/dkoosis/fo/pkg/status"
+)
+
+func convert(t *testing.T, in string) status.Status {
+	t.Helper()
+	var out bytes.Buffer
+	if err := Convert(strings.NewReader(in), &out); err != nil {
+		t.Fatalf("Convert: %v", err)
+	}
+	s, err := status.Parse(&out)
+	if err != nil {
+		t.Fatalf("output is not fo:status: %v\n%s", err, out.String())
+	}
+	return s
+}
+
+func TestConvert_ApplyGroupsByAction(t *testing.T) {
+	in := `service/web unchanged
+deployment.apps/web configured
+configmap/cfg created
+Error from server (NotFound): error when creating "job.yaml": namespaces "batch" not found
+`
+	s := convert(t, in)
+	want := []status.Row{
+		{State: status.StateFail, Label: "error",
+			Note: `Error from server (NotFound): error when creating "job.yaml": namespaces "batch" not found`},
+		{State: status.StateOK, Label: "configmap/cfg", Value: "created"},
+		{State: status.StateOK, Label: "deployment.apps/web", Value: "configured"},
+		{State: status.StateOK, Label: "service/web", Value: "unchanged"},
+	}
+	if len(s.Rows) != len(want) {
+		t.Fatalf("rows = %+v, want %d", s.Rows, len(want))
+	}
+	for i := range want {
+		if s.Rows[i] != want[i] {
+			t.Errorf("row %d = %+v, want %+v", i, s.Rows[i], want[i])
+		}
+	}
+}
+
+func TestConvert_DiffCountsChangedLines(t *testing.T) {
+	in := `diff -u -N /tmp/LIVE-1/apps.v1.Deployment.default.web /tmp/MERGED-1/apps.v1.Deployment.default.web
+--- /tmp/LIVE-1/apps.v1.Deployment.default.web
++++ /tmp/MERGED-1/apps.v1.Deployment.default.web
+@@ -6,7 +6,7 @@
+-  replicas: 2
++  replicas: 3
++  paused: false
+diff -u -N /tmp/LIVE-1/networking.k8s.io.v1.Ingress.prod.web.example /tmp/MERGED-1/networking.k8s.io.v1.Ingress.prod.web.example
+--- /tmp/LIVE-1/networking.k8s.io.v1.Ingress.prod.web.example
++++ /tmp/MERGED-1/networking.k8s.io.v1.Ingress.prod.web.example
+@@ -1,1 +1,1 @@
+-  host: a
++  host: b
+`
+	s := convert(t, in)
+	want := []status.Row{
+		{State: status.StateWarn, Label: "Deployment default/web", Value: "+2 -1"},
+		{State: status.StateWarn, Label: "Ingress prod/web.example", Value: "+1 -1"},
+	}
+	if len(s.Rows) != len(want) {
+		t.Fatalf("rows = %+v, want %d", s.Rows, len(want))
+	}
+	for i := range want {
+		if s.Rows[i] != want[i] {
+			t.Errorf("row %d = %+v, want %+v", i, s.Rows[i], want[i])
+		}
+	}
+}
+
+func TestDiffLabel_ClusterScoped(t *testing.T) {
+	if got := diffLabel("v1.Namespace..batch"); got != "Namespace batch" {
+		t.Errorf("diffLabel = %q, want %q", got, "Namespace batch")
+	}
+}
+
+func TestConvert_NoResources(t *testing.T) {
+	var out bytes.Buffer
+	if err := Convert(strings.NewReader("\n"), &out); !errors.Is(err, ErrNoResources) {
+		t.Errorf("err = %v, want ErrNoResources", err)
+	}
+}
@@ -0,0 +1,150 @@
+// Package wrapslowtests converts `go test -json` output into a fo:tally
+// of the slowest tests, so `fo` renders them as a leaderboard of seconds.
+// Only top-level tests are ranked: a subtest's time is already inside its
+// parent's Elapsed. Under -count=N a test keeps its slowest run.
+//
+// Rows are "<seconds> <pkg>.<Test>", sorted slowest first and capped at
+// Opts.Top. pkg is the import path minus the directory prefix shared by
+// every package in the run — the last element alone for a single package —
+// so a/util and b/util stay distinct rows. Non-JSON lines
+// (build chatter interleaved by go test) are skipped.
+package wrapslowtests
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/dkoosis/fo/internal/lineread"
+	"github.com/dkoosis/fo/pkg/tally"
+	"github.com/dkoosis/fo/pkg/testjson"
+)
+
+// DefaultTop is the row cap when Opts.Top is unset.
+const DefaultTop = 10
+
+// Opts carries wrapslowtests flags as plain values.
+type Opts struct {
+	Top int // rows to emit; <= 0 means DefaultTop
+}
+
+// ErrNoTests is returned when stdin carries no finished test with a
+// non-zero elapsed time.
+var ErrNoTests = errors.New("wrap slowtests: no timed tests on stdin")
+
+// testKey identifies a test by full import path, so same-named packages
+// in different directories never merge.
+type testKey struct {
+	pkg, test string
+}
+
+type timing struct {
+	label   string
+	elapsed float64
+}
+
+// Convert reads go test -json from r and writes the slowest tests to w
+// as a fo:tally stream.
+func Convert(r io.Reader, w io.Writer, opts Opts) error {
+	top := opts.Top
+	if top <= 0 {
+		top = DefaultTop
+	}
+
+	slowest := map[testKey]float64{}
+	br := bufio.NewReaderSize(r, 64*1024)
+	var dropped int
+	for {
+		raw, oversize, err := lineread.Read(br)
+		if oversize {
+			dropped++
+		} else {
+			record(slowest, raw)
+		}
+		if err == nil {
+			continue
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		return fmt.Errorf("wrap slowtests: read: %w", err)
+	}
+	if dropped > 0 {
+		fmt.Fprintf(os.Stderr, "wrap slowtests: dropped %d line(s) exceeding %d bytes\n", dropped, lineread.MaxLineLen)
+	}
+	if len(slowest) == 0 {
+		return ErrNoTests
+	}
+
+	trim := sharedDir(slowest)
+	rows := make([]timing, 0, len(slowest))
+	for k, elapsed := range slowest {
+		rows = append(rows, timing{label: strings.TrimPrefix(k.pkg, trim) + "." + k.test, elapsed: elapsed})
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		if rows[i].elapsed != rows[j].elapsed {
+			return rows[i].elapsed > rows[j].elapsed
+		}
+		return rows[i].label < rows[j].label
+	})
+	if len(rows) > top {
+		rows = rows[:top]
+	}
+
+	bw := bufio.NewWriter(w)
+	fmt.Fprintln(bw, tally.HeaderPrefix+" tool=slowtests")
+	for _, row := range rows {
+		fmt.Fprintf(bw, "%s %s\n", strconv.FormatFloat(row.elapsed, 'f', 2, 64), row.label)
+	}
+	return bw.Flush()
+}
+
+// sharedDir returns the longest directory prefix, with its trailing
+// slash, common to every package in slowest. A package is never trimmed
+// past its last element.
+func sharedDir(slowest map[testKey]float64) string {
+	var prefix string
+	first := true
+	for k := range slowest {
+		dir := path.Dir(k.pkg) + "/"
+		if dir == "./" {
+			return ""
+		}
+		if first {
+			prefix, first = dir, false
+			continue
+		}
+		for !strings.HasPrefix(dir, prefix) {
+			prefix = path.Dir(strings.TrimSuffix(prefix, "/")) + "/"
+			if prefix == "./" || prefix == "//" {
+				return ""
+			}
+		}
+	}
+	return prefix
+}
+
+// record keeps the slowest finished run of each top-level test.
+func record(slowest map[testKey]float64, raw []byte) {
+	var e testjson.TestEvent
+	if json.Unmarshal(raw, &e) != nil {
+		return
+	}
+	if e.Test == "" || strings.Contains(e.Test, "/") || e.Elapsed <= 0 {
+		return
+	}
+	if e.Action != "pass" && e.Action != "fail" {
+		return
+	}
+	k := testKey{pkg: e.Package, test: e.Test}
+	if e.Elapsed > slowest[k] {
+		slowest[k] = e.Elapsed
+	}
+}
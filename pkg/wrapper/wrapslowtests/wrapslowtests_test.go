@@ -0,0 +1,55 @@
+package wrapslowtests
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestConvert_RanksSlowestFirst(t *testing.T) {
+	t.Parallel()
+
+	in := `{"Action":"pass","Package":"ex.com/a","Test":"TestFast","Elapsed":0.01}
+{"Action":"pass","Package":"ex.com/a","Test":"TestSlow","Elapsed":2.5}
+{"Action":"pass","Package":"ex.com/a","Test":"TestSlow/case","Elapsed":2.4}
+{"Action":"fail","Package":"ex.com/b","Test":"TestMid","Elapsed":0.75}
+{"Action":"pass","Package":"ex.com/b","Test":"TestMid","Elapsed":1.25}
+# github.com/x/y
+{"Action":"pass","Package":"ex.com/b","Elapsed":3}
+`
+	var out bytes.Buffer
+	if err := Convert(strings.NewReader(in), &out, Opts{Top: 2}); err != nil {
+		t.Fatalf("Convert: %v", err)
+	}
+	want := "# fo:tally tool=slowtests\n2.50 a.TestSlow\n1.25 b.TestMid\n"
+	if out.String() != want {
+		t.Errorf("got:\n%s\nwant:\n%s", out.String(), want)
+	}
+}
+
+func TestConvert_NoTimedTests(t *testing.T) {
+	t.Parallel()
+
+	in := `{"Action":"pass","Package":"ex.com/a","Test":"TestZero","Elapsed":0}` + "\n"
+	err := Convert(strings.NewReader(in), &bytes.Buffer{}, Opts{})
+	if !errors.Is(err, ErrNoTests) {
+		t.Errorf("err = %v, want ErrNoTests", err)
+	}
+}
+
+func TestConvert_SameLastElementStaysDistinct(t *testing.T) {
+	t.Parallel()
+
+	in := `{"Action":"pass","Package":"ex.com/m/a/util","Test":"TestX","Elapsed":1}
+{"Action":"pass","Package":"ex.com/m/b/util","Test":"TestX","Elapsed":2}
+`
+	var out bytes.Buffer
+	if err := Convert(strings.NewReader(in), &out, Opts{}); err != nil {
+		t.Fatalf("Convert: %v", err)
+	}
+	want := "# fo:tally tool=slowtests\n2.00 b/util.TestX\n1.00 a/util.TestX\n"
+	if out.String() != want {
+		t.Errorf("got:\n%s\nwant:\n%s", out.String(), want)
+	}
+}